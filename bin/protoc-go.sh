@@ -14,6 +14,7 @@ rm -rf controller/gen/common controller/gen/config controller/gen/controller con
 
 gen proto/common/healthcheck.proto \
     proto/controller/tap.proto \
+    proto/controller/policy.proto \
     proto/public.proto \
     proto/config/config.proto
 
@@ -21,10 +22,12 @@ gen proto/common/healthcheck.proto \
 # As a work-around, manually move files after generation.
 mkdir -p controller/gen/common/healthcheck
 mkdir -p controller/gen/controller/tap
+mkdir -p controller/gen/controller/policy
 mkdir -p controller/gen/public
 
 mv controller/gen/common/healthcheck.pb.go   controller/gen/common/healthcheck/
 mv controller/gen/controller/tap.pb.go       controller/gen/controller/tap/
+mv controller/gen/controller/policy.pb.go    controller/gen/controller/policy/
 mv controller/gen/public.pb.go               controller/gen/public/
 
 git add controller/gen
@@ -9,12 +9,12 @@ codegen_pkg=${GOPATH}/pkg/mod/k8s.io/code-generator@${gen_ver}
 
 # ROOT_PACKAGE :: the package that is the target for code generation
 ROOT_PACKAGE=github.com/linkerd/linkerd2
-# CUSTOM_RESOURCE_NAME :: the name of the custom resource that we're generating client code for
-CUSTOM_RESOURCE_NAME=serviceprofile
-# CUSTOM_RESOURCE_VERSION :: the version of the resource
-CUSTOM_RESOURCE_VERSION=v1alpha2
+# CUSTOM_RESOURCES :: the custom resource groups and versions that we're generating client code for
+CUSTOM_RESOURCES='serviceprofile:v1alpha2 identity:v1alpha1 workload:v1alpha1 policy:v1alpha1'
 
-rm -f "${rootdir}/controller/gen/apis/${CUSTOM_RESOURCE_NAME}/${CUSTOM_RESOURCE_VERSION}/zz_generated.deepcopy.go"
+for resource in $CUSTOM_RESOURCES; do
+  rm -f "${rootdir}/controller/gen/apis/${resource%%:*}/${resource#*:}/zz_generated.deepcopy.go"
+done
 rm -rf "${rootdir}/controller/gen/client"
 rm -rf "${GOPATH}/src/${ROOT_PACKAGE}/controller/gen"
 
@@ -25,7 +25,7 @@ GO111MODULE='on' "${codegen_pkg}/generate-groups.sh" \
   'deepcopy,client,informer,lister' \
   "${ROOT_PACKAGE}/controller/gen/client" \
   "${ROOT_PACKAGE}/controller/gen/apis" \
-  "${CUSTOM_RESOURCE_NAME}:${CUSTOM_RESOURCE_VERSION}" \
+  "${CUSTOM_RESOURCES}" \
   --go-header-file "${codegen_pkg}"/hack/boilerplate.go.txt
 
 # copy generated code out of GOPATH
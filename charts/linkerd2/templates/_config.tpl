@@ -65,7 +65,13 @@
   "debugImageVersion": "{{.Values.debugContainer.image.version}}",
   "destinationGetNetworks": "{{.Values.global.proxy.destinationGetNetworks}}",
   "outboundConnectTimeout": "{{.Values.global.proxy.outboundConnectTimeout}}",
-  "inboundConnectTimeout": "{{.Values.global.proxy.inboundConnectTimeout}}"
+  "inboundConnectTimeout": "{{.Values.global.proxy.inboundConnectTimeout}}",
+  {{- with .Values.global.proxy.identityToken }}
+  "identityTokenAudience": "{{.audience}}",
+  "identityTokenExpirationSeconds": {{.expirationSeconds}}
+  {{- else }}
+  "identityTokenAudience": ""
+  {{- end }}
 }
 {{- end -}}
 
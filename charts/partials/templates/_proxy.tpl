@@ -78,7 +78,11 @@ env:
   value: |
   {{- required "Please provide the identity trust anchors" .Values.global.identityTrustAnchorsPEM | trim | nindent 4 }}
 - name: LINKERD2_PROXY_IDENTITY_TOKEN_FILE
+  {{- if (.Values.global.proxy.identityToken).audience }}
+  value: /var/run/secrets/tokens/linkerd-identity-token
+  {{- else }}
   value: /var/run/secrets/kubernetes.io/serviceaccount/token
+  {{- end }}
 - name: LINKERD2_PROXY_IDENTITY_SVC_ADDR
   {{- $identitySvcAddr := printf "linkerd-identity-headless.%s.svc.%s:8080" .Values.global.namespace .Values.global.clusterDomain }}
   value: {{ternary "localhost.:8080" $identitySvcAddr (eq .Values.global.proxy.component "linkerd-identity")}}
@@ -162,6 +166,11 @@ volumeMounts:
 {{- if not .Values.global.proxy.disableIdentity }}
 - mountPath: /var/run/linkerd/identity/end-entity
   name: linkerd-identity-end-entity
+{{- if (.Values.global.proxy.identityToken).audience }}
+- mountPath: /var/run/secrets/tokens
+  name: linkerd-identity-token
+  readOnly: true
+{{- end -}}
 {{- end -}}
 {{- if .Values.global.proxy.saMountPath }}
 - mountPath: {{.Values.global.proxy.saMountPath.mountPath}}
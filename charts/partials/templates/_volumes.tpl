@@ -4,6 +4,16 @@ emptyDir:
 name: linkerd-identity-end-entity
 {{- end -}}
 
+{{ define "partials.proxy.volumes.identityToken" -}}
+name: linkerd-identity-token
+projected:
+  sources:
+  - serviceAccountToken:
+      audience: {{.Values.global.proxy.identityToken.audience}}
+      expirationSeconds: {{.Values.global.proxy.identityToken.expirationSeconds}}
+      path: linkerd-identity-token
+{{- end -}}
+
 {{/*
 This volume is attached to the proxy when distributed tracing is enabled, thus allowing the proxy to attach pod's labels as span attributes.
 This is done to attach more context to traces in order to allow filtering based on workload type, name, namespace, etc.
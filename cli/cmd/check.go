@@ -29,6 +29,8 @@ type checkOptions struct {
 	cniEnabled         bool
 	output             string
 	cliVersionOverride string
+	plugins            bool
+	fix                bool
 }
 
 func newCheckOptions() *checkOptions {
@@ -42,6 +44,8 @@ func newCheckOptions() *checkOptions {
 		cniEnabled:         false,
 		output:             tableOutput,
 		cliVersionOverride: "",
+		plugins:            false,
+		fix:                false,
 	}
 }
 
@@ -54,6 +58,8 @@ func (options *checkOptions) nonConfigFlagSet() *pflag.FlagSet {
 	flags.BoolVar(&options.preInstallOnly, "pre", options.preInstallOnly, "Only run pre-installation checks, to determine if the control plane can be installed")
 	flags.BoolVar(&options.dataPlaneOnly, "proxy", options.dataPlaneOnly, "Only run data-plane checks, to determine if the data plane is healthy")
 	flags.BoolVar(&options.multicluster, "multicluster", options.multicluster, "Run multicluster checks")
+	flags.BoolVar(&options.plugins, "plugins", options.plugins, fmt.Sprintf("Run the check plugins found in PATH, executables named %s<name>", healthcheck.CheckPluginPrefix))
+	flags.BoolVar(&options.fix, "fix", options.fix, "Offer to fix the failed checks that can be fixed safely, asking for confirmation before each fix")
 
 	return flags
 }
@@ -64,7 +70,7 @@ func (options *checkOptions) checkFlagSet() *pflag.FlagSet {
 
 	flags.StringVar(&options.versionOverride, "expected-version", options.versionOverride, "Overrides the version used when checking if Linkerd is running the latest version (mostly for testing)")
 	flags.StringVar(&options.cliVersionOverride, "cli-version-override", "", "Used to override the version of the cli (mostly for testing)")
-	flags.StringVarP(&options.output, "output", "o", options.output, "Output format. One of: basic, json")
+	flags.StringVarP(&options.output, "output", "o", options.output, "Output format. One of: basic, json, junit, sarif")
 	flags.DurationVar(&options.wait, "wait", options.wait, "Maximum allowed time for all tests to pass")
 
 	return flags
@@ -77,12 +83,56 @@ func (options *checkOptions) validate() error {
 	if !options.preInstallOnly && options.cniEnabled {
 		return errors.New("--linkerd-cni-enabled can only be used with --pre")
 	}
-	if options.output != tableOutput && options.output != jsonOutput {
-		return fmt.Errorf("Invalid output type '%s'. Supported output types are: %s, %s", options.output, jsonOutput, tableOutput)
+	if options.output != tableOutput && options.output != jsonOutput && options.output != junitOutput && options.output != sarifOutput {
+		return fmt.Errorf("Invalid output type '%s'. Supported output types are: %s, %s, %s, %s", options.output, jsonOutput, junitOutput, sarifOutput, tableOutput)
+	}
+	if options.fix && options.output != tableOutput {
+		return fmt.Errorf("--fix can only be used with the %s output", tableOutput)
 	}
 	return nil
 }
 
+// checkCategories returns the categories of the checks to run for the stage.
+func (options *checkOptions) checkCategories(stage string) []healthcheck.CategoryID {
+	checks := []healthcheck.CategoryID{
+		healthcheck.KubernetesAPIChecks,
+		healthcheck.KubernetesVersionChecks,
+		healthcheck.LinkerdVersionChecks,
+	}
+
+	if options.preInstallOnly {
+		checks = append(checks, healthcheck.LinkerdPreInstallChecks)
+		if options.cniEnabled {
+			checks = append(checks, healthcheck.LinkerdCNIPluginChecks)
+		} else {
+			checks = append(checks, healthcheck.LinkerdPreInstallCapabilityChecks)
+		}
+		return checks
+	}
+
+	checks = append(checks, healthcheck.LinkerdConfigChecks)
+
+	if stage != configStage {
+		checks = append(checks, healthcheck.LinkerdControlPlaneExistenceChecks)
+		checks = append(checks, healthcheck.LinkerdAPIChecks)
+		checks = append(checks, healthcheck.LinkerdIdentity)
+		checks = append(checks, healthcheck.LinkerdWebhooksAndAPISvcTLS)
+
+		if options.dataPlaneOnly {
+			checks = append(checks, healthcheck.LinkerdDataPlaneChecks)
+			checks = append(checks, healthcheck.LinkerdIdentityDataPlane)
+		} else {
+			checks = append(checks, healthcheck.LinkerdControlPlaneVersionChecks)
+		}
+		checks = append(checks, healthcheck.LinkerdCNIPluginChecks)
+		checks = append(checks, healthcheck.LinkerdHAChecks)
+		checks = append(checks, healthcheck.LinkerdMulticlusterChecks)
+
+		checks = append(checks, healthcheck.AddOnCategories...)
+	}
+	return checks
+}
+
 // newCmdCheckConfig is a subcommand for `linkerd check config`
 func newCmdCheckConfig(options *checkOptions) *cobra.Command {
 	cmd := &cobra.Command{
@@ -120,7 +170,22 @@ func newCmdCheck() *cobra.Command {
 The check command will perform a series of checks to validate that the linkerd
 CLI and control plane are configured correctly. If the command encounters a
 failure it will print additional information about the failure and exit with a
-non-zero exit code.`,
+non-zero exit code.
+
+Additional checks can be provided by check plugins: executables in PATH named
+"linkerd-check-<name>". With --plugins, each plugin is run after the Linkerd
+checks with the LINKERD_NAMESPACE, LINKERD_DATA_PLANE_NAMESPACE and
+LINKERD_KUBE_CONTEXT environment variables set (and KUBECONFIG, with
+--kubeconfig), and must print a single category in the format of
+"linkerd check -o json":
+
+  {"categoryName": "acme", "checks": [{"description": "...", "result": "error",
+    "error": "...", "hint": "https://wiki.acme.org/linkerd#hint"}]}
+
+where result is one of success, warning or error, and hint is either an anchor
+of https://linkerd.io/checks or an absolute URL. The checks are reported in the
+"<name>" category, or "<name>/<categoryName>" if the plugin names one. Plugins
+are not run by --pre.`,
 		Example: `  # Check that the Linkerd control plane is up and running
   linkerd check
 
@@ -131,7 +196,13 @@ non-zero exit code.`,
   linkerd check config
 
   # Check that the Linkerd data plane proxies in the "app" namespace are up and running
-  linkerd check --proxy --namespace app`,
+  linkerd check --proxy --namespace app
+
+  # Report the checks as JUnit test results, e.g. for a CI system
+  linkerd check -o junit > linkerd-check.xml
+
+  # Fix the failed checks that can be fixed, such as outdated data plane proxies
+  linkerd check --proxy --fix`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return configureAndRunChecks(cmd.Context(), stdout, stderr, "", options)
 		},
@@ -155,45 +226,14 @@ func configureAndRunChecks(ctx context.Context, wout io.Writer, werr io.Writer,
 		version.Version = options.cliVersionOverride
 	}
 
-	checks := []healthcheck.CategoryID{
-		healthcheck.KubernetesAPIChecks,
-		healthcheck.KubernetesVersionChecks,
-		healthcheck.LinkerdVersionChecks,
-	}
+	checks := options.checkCategories(stage)
 
 	var installManifest string
 	if options.preInstallOnly {
-		checks = append(checks, healthcheck.LinkerdPreInstallChecks)
-		if options.cniEnabled {
-			checks = append(checks, healthcheck.LinkerdCNIPluginChecks)
-		} else {
-			checks = append(checks, healthcheck.LinkerdPreInstallCapabilityChecks)
-		}
 		installManifest, err = renderInstallManifest(ctx)
 		if err != nil {
 			return fmt.Errorf("Error rendering install manifest: %v", err)
 		}
-	} else {
-		checks = append(checks, healthcheck.LinkerdConfigChecks)
-
-		if stage != configStage {
-			checks = append(checks, healthcheck.LinkerdControlPlaneExistenceChecks)
-			checks = append(checks, healthcheck.LinkerdAPIChecks)
-			checks = append(checks, healthcheck.LinkerdIdentity)
-			checks = append(checks, healthcheck.LinkerdWebhooksAndAPISvcTLS)
-
-			if options.dataPlaneOnly {
-				checks = append(checks, healthcheck.LinkerdDataPlaneChecks)
-				checks = append(checks, healthcheck.LinkerdIdentityDataPlane)
-			} else {
-				checks = append(checks, healthcheck.LinkerdControlPlaneVersionChecks)
-			}
-			checks = append(checks, healthcheck.LinkerdCNIPluginChecks)
-			checks = append(checks, healthcheck.LinkerdHAChecks)
-			checks = append(checks, healthcheck.LinkerdMulticlusterChecks)
-
-			checks = append(checks, healthcheck.AddOnCategories...)
-		}
 	}
 
 	hc := healthcheck.NewHealthChecker(checks, &healthcheck.Options{
@@ -211,8 +251,22 @@ func configureAndRunChecks(ctx context.Context, wout io.Writer, werr io.Writer,
 		InstallManifest:       installManifest,
 		MultiCluster:          options.multicluster,
 	})
+	if options.plugins && !options.preInstallOnly && stage != configStage {
+		if err := hc.AddCheckPlugins(healthcheck.FindCheckPlugins(os.Getenv("PATH"))...); err != nil {
+			return err
+		}
+	}
 
-	success := runChecks(wout, werr, hc, options.output)
+	if !options.fix {
+		if !runChecks(wout, werr, hc, options.output) {
+			os.Exit(1)
+		}
+		return nil
+	}
+
+	fixable := &fixableChecks{checker: hc}
+	success := runChecks(wout, werr, fixable, options.output)
+	fixChecks(ctx, wout, os.Stdin, fixable.results)
 
 	if !success {
 		os.Exit(1)
@@ -221,14 +275,25 @@ func configureAndRunChecks(ctx context.Context, wout io.Writer, werr io.Writer,
 	return nil
 }
 
-func runChecks(wout io.Writer, werr io.Writer, hc *healthcheck.HealthChecker, output string) bool {
-	if output == jsonOutput {
+// checker runs checks, passing their results to an observer.
+type checker interface {
+	RunChecks(observer healthcheck.CheckObserver) bool
+}
+
+func runChecks(wout io.Writer, werr io.Writer, hc checker, output string) bool {
+	switch output {
+	case jsonOutput:
 		return runChecksJSON(wout, werr, hc)
+	case junitOutput:
+		return runChecksJUnit(wout, werr, hc)
+	case sarifOutput:
+		return runChecksSARIF(wout, werr, hc)
+	default:
+		return runChecksTable(wout, hc)
 	}
-	return runChecksTable(wout, hc)
 }
 
-func runChecksTable(wout io.Writer, hc *healthcheck.HealthChecker) bool {
+func runChecksTable(wout io.Writer, hc checker) bool {
 	var lastCategory healthcheck.CategoryID
 	spin := spinner.New(spinner.CharSets[9], 100*time.Millisecond)
 	spin.Writer = wout
@@ -266,7 +331,7 @@ func runChecksTable(wout io.Writer, hc *healthcheck.HealthChecker) bool {
 		if result.Err != nil {
 			fmt.Fprintf(wout, "    %s\n", result.Err)
 			if result.HintAnchor != "" {
-				fmt.Fprintf(wout, "    see %s%s for hints\n", healthcheck.HintBaseURL, result.HintAnchor)
+				fmt.Fprintf(wout, "    see %s for hints\n", healthcheck.HintURL(result.HintAnchor))
 			}
 		}
 	}
@@ -311,10 +376,29 @@ const (
 	checkErr     checkResult = "error"
 )
 
-func runChecksJSON(wout io.Writer, werr io.Writer, hc *healthcheck.HealthChecker) bool {
+func runChecksJSON(wout io.Writer, werr io.Writer, hc checker) bool {
+	result, categories := collectCheckCategories(hc)
+
+	outputJSON := checkOutput{
+		Success:    result,
+		Categories: categories,
+	}
+
+	resultJSON, err := json.MarshalIndent(outputJSON, "", "  ")
+	if err == nil {
+		fmt.Fprintf(wout, "%s\n", string(resultJSON))
+	} else {
+		fmt.Fprintf(werr, "JSON serialization of the check result failed with %s", err)
+	}
+	return result
+}
+
+// collectCheckCategories runs the checks, and returns their final results
+// grouped by category.
+func collectCheckCategories(hc checker) (bool, []*checkCategory) {
 	var categories []*checkCategory
 
-	collectJSONOutput := func(result *healthcheck.CheckResult) {
+	collectResults := func(result *healthcheck.CheckResult) {
 		categoryName := string(result.Category)
 		if categories == nil || categories[len(categories)-1].Name != categoryName {
 			categories = append(categories, &checkCategory{
@@ -343,27 +427,15 @@ func runChecksJSON(wout io.Writer, werr io.Writer, hc *healthcheck.HealthChecker
 				currentCheck.Error = result.Err.Error()
 
 				if result.HintAnchor != "" {
-					currentCheck.Hint = fmt.Sprintf("%s%s", healthcheck.HintBaseURL, result.HintAnchor)
+					currentCheck.Hint = healthcheck.HintURL(result.HintAnchor)
 				}
 			}
 			currentCategory.Checks = append(currentCategory.Checks, currentCheck)
 		}
 	}
 
-	result := hc.RunChecks(collectJSONOutput)
-
-	outputJSON := checkOutput{
-		Success:    result,
-		Categories: categories,
-	}
-
-	resultJSON, err := json.MarshalIndent(outputJSON, "", "  ")
-	if err == nil {
-		fmt.Fprintf(wout, "%s\n", string(resultJSON))
-	} else {
-		fmt.Fprintf(werr, "JSON serialization of the check result failed with %s", err)
-	}
-	return result
+	result := hc.RunChecks(collectResults)
+	return result, categories
 }
 
 func renderInstallManifest(ctx context.Context) (string, error) {
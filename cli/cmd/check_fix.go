@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+)
+
+// fixableChecks runs the checks of a checker, keeping the results of the
+// failed checks that can be fixed.
+type fixableChecks struct {
+	checker checker
+	results []*healthcheck.CheckResult
+}
+
+func (f *fixableChecks) RunChecks(observer healthcheck.CheckObserver) bool {
+	return f.checker.RunChecks(func(result *healthcheck.CheckResult) {
+		if !result.Retry && result.Err != nil && result.PlanFix != nil {
+			f.results = append(f.results, result)
+		}
+		observer(result)
+	})
+}
+
+// fixChecks plans the fixes of the failed checks, and applies those the user
+// confirms through in.
+func fixChecks(ctx context.Context, wout io.Writer, in io.Reader, results []*healthcheck.CheckResult) {
+	if len(results) == 0 {
+		return
+	}
+
+	fmt.Fprintln(wout)
+	fmt.Fprintln(wout, "fixes")
+	fmt.Fprintln(wout, strings.Repeat("-", len("fixes")))
+
+	reader := bufio.NewReader(in)
+	fixed := 0
+	for _, result := range results {
+		description := strings.SplitN(result.Description, "\n", 2)[0]
+		fix, err := result.PlanFix(ctx)
+		if err != nil {
+			fmt.Fprintf(wout, "%s [%s] %s\n    cannot be fixed: %s\n", failStatus, result.Category, description, err)
+			continue
+		}
+
+		fmt.Fprintf(wout, "[%s] %s\n    fix: %s\n    apply this fix? [y/N] ", result.Category, description, fix.Description)
+		answer, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			fmt.Fprintf(wout, "\n%s failed to read the answer: %s\n", failStatus, err)
+			return
+		}
+		answer = strings.ToLower(strings.TrimSpace(answer))
+		if answer != "y" && answer != "yes" {
+			if err == io.EOF {
+				fmt.Fprintln(wout)
+			}
+			fmt.Fprintf(wout, "%s skipped\n", warnStatus)
+			continue
+		}
+
+		if err := fix.Apply(ctx); err != nil {
+			fmt.Fprintf(wout, "%s %s\n", failStatus, err)
+			continue
+		}
+		fmt.Fprintf(wout, "%s fixed\n", okStatus)
+		fixed++
+	}
+
+	if fixed > 0 {
+		fmt.Fprintln(wout)
+		fmt.Fprintln(wout, "Run linkerd check again to verify the fixes")
+	}
+}
@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/version"
+)
+
+// The JUnit output maps check categories to test suites, and checks to test
+// cases. Warnings are reported as skipped test cases, so that they don't fail
+// the build.
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Name     string            `xml:"name,attr"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Skipped  int               `xml:"skipped,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Cases    []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func runChecksJUnit(wout io.Writer, werr io.Writer, hc checker) bool {
+	result, categories := collectCheckCategories(hc)
+
+	suites := junitTestSuites{Name: "linkerd check"}
+	for _, category := range categories {
+		suite := &junitTestSuite{Name: category.Name, Cases: []*junitTestCase{}}
+		for _, check := range category.Checks {
+			testCase := &junitTestCase{
+				Name:      check.Description,
+				ClassName: category.Name,
+			}
+			message := &junitMessage{Message: check.Error, Text: checkDetails(check)}
+			switch check.Result {
+			case checkErr:
+				testCase.Failure = message
+				suite.Failures++
+			case checkWarn:
+				testCase.Skipped = message
+				suite.Skipped++
+			}
+			suite.Cases = append(suite.Cases, testCase)
+			suite.Tests++
+		}
+		suites.Suites = append(suites.Suites, suite)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Skipped += suite.Skipped
+	}
+
+	out, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		fmt.Fprintf(werr, "JUnit serialization of the check result failed with %s", err)
+		return result
+	}
+	fmt.Fprintf(wout, "%s%s\n", xml.Header, out)
+	return result
+}
+
+// The SARIF output reports each check as a rule, with its hint as the rule's
+// help, and the outcome of the check as a result of the rule. Errors are
+// reported with the error level, and warnings with the note level.
+type sarifLog struct {
+	Version string      `json:"version"`
+	Schema  string      `json:"$schema"`
+	Runs    []*sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool      `json:"tool"`
+	Results []*sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string       `json:"name"`
+	Version        string       `json:"version"`
+	InformationURI string       `json:"informationUri"`
+	Rules          []*sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+	HelpURI          string       `json:"helpUri,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID    string       `json:"ruleId"`
+	RuleIndex int          `json:"ruleIndex"`
+	Kind      string       `json:"kind"`
+	Level     string       `json:"level"`
+	Message   sarifMessage `json:"message"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+var nonAlphanumeric = regexp.MustCompile("[^a-z0-9]+")
+
+func runChecksSARIF(wout io.Writer, werr io.Writer, hc checker) bool {
+	result, categories := collectCheckCategories(hc)
+
+	run := &sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "linkerd check",
+				Version:        version.Version,
+				InformationURI: strings.TrimSuffix(healthcheck.HintBaseURL, "#"),
+				Rules:          []*sarifRule{},
+			},
+		},
+		Results: []*sarifResult{},
+	}
+	for _, category := range categories {
+		for _, check := range category.Checks {
+			description := strings.SplitN(check.Description, "\n", 2)[0]
+			rule := &sarifRule{
+				ID:               fmt.Sprintf("%s/%s", category.Name, strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(description), "-"), "-")),
+				ShortDescription: sarifMessage{Text: description},
+				HelpURI:          check.Hint,
+			}
+			sarifResult := &sarifResult{
+				RuleID:    rule.ID,
+				RuleIndex: len(run.Tool.Driver.Rules),
+				Kind:      "pass",
+				Level:     "none",
+				Message:   sarifMessage{Text: check.Description},
+			}
+			switch check.Result {
+			case checkErr:
+				sarifResult.Kind = "fail"
+				sarifResult.Level = "error"
+				sarifResult.Message.Text = checkDetails(check)
+			case checkWarn:
+				sarifResult.Kind = "fail"
+				sarifResult.Level = "note"
+				sarifResult.Message.Text = checkDetails(check)
+			}
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, rule)
+			run.Results = append(run.Results, sarifResult)
+		}
+	}
+
+	out, err := json.MarshalIndent(sarifLog{Version: sarifVersion, Schema: sarifSchema, Runs: []*sarifRun{run}}, "", "  ")
+	if err != nil {
+		fmt.Fprintf(werr, "SARIF serialization of the check result failed with %s", err)
+		return result
+	}
+	fmt.Fprintf(wout, "%s\n", out)
+	return result
+}
+
+// checkDetails describes the failure of a check, with its hint.
+func checkDetails(c *check) string {
+	details := fmt.Sprintf("%s: %s", c.Description, c.Error)
+	if c.Hint != "" {
+		details = fmt.Sprintf("%s\nsee %s for hints", details, c.Hint)
+	}
+	return details
+}
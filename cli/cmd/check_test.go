@@ -3,8 +3,11 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/linkerd/linkerd2/pkg/healthcheck"
@@ -64,4 +67,71 @@ func TestCheckStatus(t *testing.T) {
 			t.Fatalf("Expected function to render:\n%s\bbut got:\n%s", expectedContent, output)
 		}
 	})
+
+	for _, output := range []string{junitOutput, sarifOutput} {
+		output := output // pin
+		t.Run(fmt.Sprintf("Prints expected output in %s", output), func(t *testing.T) {
+			hc := healthcheck.NewHealthChecker(
+				[]healthcheck.CategoryID{},
+				&healthcheck.Options{},
+			)
+			hc.Add("category", "check1", "", func(context.Context) error {
+				return nil
+			})
+			hc.Add("category", "check2", "hint-anchor", func(context.Context) error {
+				return fmt.Errorf("This should contain instructions for fail")
+			})
+
+			buf := bytes.NewBufferString("")
+			runChecks(buf, stderr, hc, output)
+
+			diffTestdata(t, fmt.Sprintf("check_output_%s.golden", output), buf.String())
+		})
+	}
+}
+
+func TestFixChecks(t *testing.T) {
+	applied := []string{}
+	planFix := func(name string) func(context.Context) (*healthcheck.Fix, error) {
+		return func(context.Context) (*healthcheck.Fix, error) {
+			return &healthcheck.Fix{
+				Description: fmt.Sprintf("fix %s", name),
+				Apply: func(context.Context) error {
+					applied = append(applied, name)
+					return nil
+				},
+			}, nil
+		}
+	}
+	results := []*healthcheck.CheckResult{
+		{Category: "category", Description: "check1", Err: errors.New("error"), PlanFix: planFix("check1")},
+		{Category: "category", Description: "check2", Err: errors.New("error"), PlanFix: planFix("check2")},
+		{Category: "category", Description: "check3", Err: errors.New("error"), PlanFix: func(context.Context) (*healthcheck.Fix, error) {
+			return nil, errors.New("nothing to fix")
+		}},
+	}
+
+	output := bytes.NewBufferString("")
+	fixChecks(context.Background(), output, strings.NewReader("y\nn\n"), results)
+
+	expectedOutput := `
+fixes
+-----
+[category] check1
+    fix: fix check1
+    apply this fix? [y/N] √ fixed
+[category] check2
+    fix: fix check2
+    apply this fix? [y/N] ‼ skipped
+× [category] check3
+    cannot be fixed: nothing to fix
+
+Run linkerd check again to verify the fixes
+`
+	if output.String() != expectedOutput {
+		t.Fatalf("Expected output:\n%s\nbut got:\n%s", expectedOutput, output)
+	}
+	if !reflect.DeepEqual(applied, []string{"check1"}) {
+		t.Fatalf("Expected only the fix of check1 to be applied, got %v", applied)
+	}
 }
@@ -40,7 +40,10 @@ func newCmdDiagnostics() *cobra.Command {
 		Long: `Fetch metrics directly from Linkerd control plane containers.
 
   This command initiates port-forward to each control plane process, and
-  queries the /metrics endpoint on them.`,
+  queries the /metrics endpoint on them.
+
+  The bundle subcommand collects a support bundle of the installation, and the
+  proxy-metrics subcommand aggregates the metrics of the proxies of a resource.`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
@@ -74,5 +77,8 @@ func newCmdDiagnostics() *cobra.Command {
 
 	cmd.Flags().DurationVarP(&options.wait, "wait", "w", options.wait, "Time allowed to fetch diagnostics")
 
+	cmd.AddCommand(newCmdDiagnosticsBundle())
+	cmd.AddCommand(newCmdDiagnosticsProxyMetrics())
+
 	return cmd
 }
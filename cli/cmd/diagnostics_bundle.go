@@ -0,0 +1,428 @@
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/multicluster"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	bundleDir      = "linkerd-diagnostics"
+	redactedValue  = "<redacted>"
+	bundleFileMode = 0644
+)
+
+// secretValueKey matches the keys of the linkerd-config values holding
+// private keys or credentials, which are redacted from the bundle.
+var secretValueKey = regexp.MustCompile(`(?i)(key|keypem|password|secret|token)$`)
+
+// bundleOptions holds values for command line flags that apply to the
+// diagnostics bundle command.
+type bundleOptions struct {
+	outputFile  string
+	since       time.Duration
+	proxySample int
+	wait        time.Duration
+}
+
+func newBundleOptions() *bundleOptions {
+	return &bundleOptions{
+		outputFile:  "",
+		since:       time.Hour,
+		proxySample: 5,
+		wait:        30 * time.Second,
+	}
+}
+
+// bundleWriter writes the files of a diagnostics bundle to a gzipped
+// tarball. The parts that can't be collected are listed in errors.txt, so
+// that a partial bundle is still useful.
+type bundleWriter struct {
+	tw      *tar.Writer
+	modTime time.Time
+	errors  []string
+	werr    io.Writer
+}
+
+func newBundleWriter(w io.Writer, werr io.Writer) (*bundleWriter, *gzip.Writer) {
+	gw := gzip.NewWriter(w)
+	return &bundleWriter{
+		tw:      tar.NewWriter(gw),
+		modTime: time.Now(),
+		werr:    werr,
+	}, gw
+}
+
+func (b *bundleWriter) add(name string, content []byte) error {
+	err := b.tw.WriteHeader(&tar.Header{
+		Name:    path.Join(bundleDir, name),
+		Mode:    bundleFileMode,
+		Size:    int64(len(content)),
+		ModTime: b.modTime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = b.tw.Write(content)
+	return err
+}
+
+func (b *bundleWriter) addYAML(name string, obj interface{}) error {
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return b.add(name, out)
+}
+
+// fail records that part of the bundle couldn't be collected.
+func (b *bundleWriter) fail(part string, err error) {
+	msg := fmt.Sprintf("%s: %s", part, err)
+	b.errors = append(b.errors, msg)
+	fmt.Fprintf(b.werr, "Failed to collect %s\n", msg)
+}
+
+func (b *bundleWriter) close() error {
+	if len(b.errors) > 0 {
+		if err := b.add("errors.txt", []byte(strings.Join(b.errors, "\n")+"\n")); err != nil {
+			return err
+		}
+	}
+	return b.tw.Close()
+}
+
+func newCmdDiagnosticsBundle() *cobra.Command {
+	options := newBundleOptions()
+
+	cmd := &cobra.Command{
+		Use:   "bundle [flags]",
+		Short: "Collect a support bundle of the Linkerd installation",
+		Long: `Collect a support bundle of the Linkerd installation.
+
+  This command writes a single tarball containing the output of linkerd check,
+  the linkerd-config values with private keys and credentials redacted, the
+  logs of the control plane containers, the metrics of the control plane
+  containers and of a sample of the meshed proxies, the recent events of the
+  control plane namespace and the recent warning events of the cluster, and
+  the multicluster Link resources.`,
+		Example: `  # Write the bundle to linkerd-diagnostics-<timestamp>.tar.gz
+  linkerd diagnostics bundle
+
+  # Include the logs of the last 6 hours, and the metrics of 10 proxies
+  linkerd diagnostics bundle --since 6h --proxy-sample 10 -f bundle.tar.gz`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if options.proxySample < 0 {
+				return fmt.Errorf("--proxy-sample must be non-negative")
+			}
+			if options.outputFile == "" {
+				options.outputFile = fmt.Sprintf("%s-%s.tar.gz", bundleDir, time.Now().Format("20060102-150405"))
+			}
+
+			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Create(options.outputFile)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			b, gw := newBundleWriter(f, os.Stderr)
+			collectBundle(cmd.Context(), k8sAPI, options, b)
+			if err := b.close(); err != nil {
+				return err
+			}
+			if err := gw.Close(); err != nil {
+				return err
+			}
+
+			fmt.Printf("Wrote diagnostics bundle to %s\n", options.outputFile)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.outputFile, "output-file", "f", options.outputFile, "Path of the bundle to write (default linkerd-diagnostics-<timestamp>.tar.gz)")
+	cmd.Flags().DurationVar(&options.since, "since", options.since, "Only collect the logs and events more recent than a relative duration like 5s, 2m, or 3h")
+	cmd.Flags().IntVar(&options.proxySample, "proxy-sample", options.proxySample, "Number of meshed pods to collect proxy metrics from")
+	cmd.Flags().DurationVarP(&options.wait, "wait", "w", options.wait, "Time allowed to fetch the metrics of each container")
+
+	return cmd
+}
+
+// collectBundle writes each part of the bundle, recording the parts that fail.
+func collectBundle(ctx context.Context, k8sAPI *k8s.KubernetesAPI, options *bundleOptions, b *bundleWriter) {
+	parts := []struct {
+		name    string
+		collect func() error
+	}{
+		{"check output", func() error { return collectCheck(b) }},
+		{"linkerd-config", func() error { return collectConfig(ctx, k8sAPI, b) }},
+		{"control plane logs", func() error { return collectLogs(ctx, options, b) }},
+		{"control plane metrics", func() error { return collectControlPlaneMetrics(ctx, k8sAPI, options, b) }},
+		{"proxy metrics", func() error { return collectProxyMetrics(ctx, k8sAPI, options, b) }},
+		{"events", func() error { return collectEvents(ctx, k8sAPI, options, b) }},
+		{"links", func() error { return collectLinks(ctx, k8sAPI, b) }},
+	}
+	for _, part := range parts {
+		if err := part.collect(); err != nil {
+			b.fail(part.name, err)
+		}
+	}
+}
+
+// collectCheck runs the checks `linkerd check` runs, without waiting for the
+// control plane to become ready.
+func collectCheck(b *bundleWriter) error {
+	options := newCheckOptions()
+	hc := healthcheck.NewHealthChecker(options.checkCategories(""), &healthcheck.Options{
+		ControlPlaneNamespace: controlPlaneNamespace,
+		CNINamespace:          cniNamespace,
+		KubeConfig:            kubeconfigPath,
+		KubeContext:           kubeContext,
+		Impersonate:           impersonate,
+		ImpersonateGroup:      impersonateGroup,
+		APIAddr:               apiAddr,
+		RetryDeadline:         time.Now(),
+	})
+
+	var out, errOut bytes.Buffer
+	runChecksJSON(&out, &errOut, hc)
+	if errOut.Len() > 0 {
+		return fmt.Errorf("%s", errOut.String())
+	}
+	return b.add("check.json", out.Bytes())
+}
+
+func collectConfig(ctx context.Context, k8sAPI *k8s.KubernetesAPI, b *bundleWriter) error {
+	_, values, err := healthcheck.FetchCurrentConfiguration(ctx, k8sAPI, controlPlaneNamespace)
+	if err != nil {
+		return err
+	}
+
+	// Round-trip the values through JSON to redact them by key.
+	out, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	var redacted map[string]interface{}
+	if err := json.Unmarshal(out, &redacted); err != nil {
+		return err
+	}
+	redactValues(redacted)
+
+	return b.addYAML("linkerd-config.yaml", redacted)
+}
+
+// redactValues replaces the non-empty string values of the keys matching
+// secretValueKey, recursively.
+func redactValues(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if s, ok := val.(string); ok {
+				if s != "" && secretValueKey.MatchString(key) {
+					v[key] = redactedValue
+				}
+				continue
+			}
+			redactValues(val)
+		}
+	case []interface{}:
+		for _, val := range v {
+			redactValues(val)
+		}
+	}
+}
+
+// collectLogs fetches the logs of the control plane containers `linkerd logs`
+// would tail, up to now.
+func collectLogs(ctx context.Context, options *bundleOptions, b *bundleWriter) error {
+	logsOptions := newLogsOptions()
+	logsOptions.sinceSeconds = options.since
+	logsOptions.timestamps = true
+	config, err := newLogCmdConfig(ctx, logsOptions, kubeconfigPath, kubeContext, impersonate, impersonateGroup)
+	if err != nil {
+		return err
+	}
+
+	pods, err := config.clientset.CoreV1().Pods(config.Namespace).List(ctx, metav1.ListOptions{LabelSelector: config.LabelSelector.String()})
+	if err != nil {
+		return err
+	}
+
+	sinceSeconds := int64(config.Since.Seconds())
+	for _, pod := range pods.Items {
+		for _, container := range pod.Spec.Containers {
+			if !config.ContainerQuery.MatchString(container.Name) {
+				continue
+			}
+			logs, err := config.clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+				Container:    container.Name,
+				SinceSeconds: &sinceSeconds,
+				TailLines:    config.TailLines,
+				Timestamps:   config.Timestamps,
+			}).DoRaw(ctx)
+			if err != nil {
+				b.fail(fmt.Sprintf("logs of %s/%s", pod.Name, container.Name), err)
+				continue
+			}
+			if err := b.add(path.Join("logs", pod.Name, container.Name+".log"), logs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func collectControlPlaneMetrics(ctx context.Context, k8sAPI *k8s.KubernetesAPI, options *bundleOptions, b *bundleWriter) error {
+	pods, err := k8sAPI.CoreV1().Pods(controlPlaneNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	return addMetrics(k8sAPI, pods.Items, adminHTTPPortName, "metrics/control-plane", options, b)
+}
+
+// collectProxyMetrics fetches the proxy metrics of a sample of the running
+// meshed pods outside of the control plane namespace.
+func collectProxyMetrics(ctx context.Context, k8sAPI *k8s.KubernetesAPI, options *bundleOptions, b *bundleWriter) error {
+	if options.proxySample == 0 {
+		return nil
+	}
+
+	selector := fmt.Sprintf("%s=%s", k8s.ControllerNSLabel, controlPlaneNamespace)
+	podList, err := k8sAPI.CoreV1().Pods("").List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return err
+	}
+
+	meshed := []corev1.Pod{}
+	for _, pod := range podList.Items {
+		if pod.Namespace != controlPlaneNamespace && pod.Status.Phase == corev1.PodRunning {
+			meshed = append(meshed, pod)
+		}
+	}
+
+	byNamespace := map[string][]corev1.Pod{}
+	for _, pod := range sampleProxyPods(meshed, options.proxySample) {
+		byNamespace[pod.Namespace] = append(byNamespace[pod.Namespace], pod)
+	}
+	namespaces := []string{}
+	for ns := range byNamespace {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+
+	for _, ns := range namespaces {
+		if err := addMetrics(k8sAPI, byNamespace[ns], k8s.ProxyAdminPortName, path.Join("metrics/proxies", ns), options, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sampleProxyPods returns n of the pods, evenly spaced in namespace and name
+// order so that the sample spans the namespaces of the mesh.
+func sampleProxyPods(pods []corev1.Pod, n int) []corev1.Pod {
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+		return pods[i].Name < pods[j].Name
+	})
+	if len(pods) <= n {
+		return pods
+	}
+
+	sample := make([]corev1.Pod, n)
+	for i := range sample {
+		sample[i] = pods[i*len(pods)/n]
+	}
+	return sample
+}
+
+func addMetrics(k8sAPI *k8s.KubernetesAPI, pods []corev1.Pod, portName, dir string, options *bundleOptions, b *bundleWriter) error {
+	for _, result := range getMetrics(k8sAPI, pods, portName, options.wait, verbose) {
+		if result.err != nil {
+			b.fail(fmt.Sprintf("metrics of %s/%s", dir, result.pod), result.err)
+			continue
+		}
+		if err := b.add(path.Join(dir, result.pod, result.container+".txt"), result.metrics); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectEvents writes the events of the control plane namespace, and the
+// warning events of all namespaces, more recent than --since.
+func collectEvents(ctx context.Context, k8sAPI *k8s.KubernetesAPI, options *bundleOptions, b *bundleWriter) error {
+	since := time.Now().Add(-options.since)
+
+	events, err := k8sAPI.CoreV1().Events(controlPlaneNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	if err := b.addYAML("events/control-plane.yaml", recentEvents(events.Items, since)); err != nil {
+		return err
+	}
+
+	warnings, err := k8sAPI.CoreV1().Events("").List(ctx, metav1.ListOptions{FieldSelector: "type=" + corev1.EventTypeWarning})
+	if err != nil {
+		return err
+	}
+	return b.addYAML("events/warnings.yaml", recentEvents(warnings.Items, since))
+}
+
+// recentEvents returns the events last seen after since, oldest first.
+func recentEvents(events []corev1.Event, since time.Time) []corev1.Event {
+	recent := []corev1.Event{}
+	for _, event := range events {
+		if lastSeen(event).After(since) {
+			recent = append(recent, event)
+		}
+	}
+	sort.SliceStable(recent, func(i, j int) bool { return lastSeen(recent[i]).Before(lastSeen(recent[j])) })
+	return recent
+}
+
+func lastSeen(event corev1.Event) time.Time {
+	// Events created through the events.k8s.io API only have an event time.
+	if event.LastTimestamp.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.LastTimestamp.Time
+}
+
+func collectLinks(ctx context.Context, k8sAPI *k8s.KubernetesAPI, b *bundleWriter) error {
+	links, err := k8sAPI.DynamicClient.Resource(multicluster.LinkGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		// The Link CRD is only installed with the multicluster components.
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return b.addYAML("links.yaml", links.Items)
+}
@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+// readBundle returns the files of a bundle by name.
+func readBundle(t *testing.T, bundle []byte) map[string]string {
+	t.Helper()
+	gr, err := gzip.NewReader(bytes.NewReader(bundle))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	tr := tar.NewReader(gr)
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		files[hdr.Name] = string(content)
+	}
+}
+
+func TestRedactValues(t *testing.T) {
+	values := map[string]interface{}{
+		"identityTrustAnchorsPEM": "trust anchors",
+		"identity": map[string]interface{}{
+			"issuer": map[string]interface{}{
+				"tls": map[string]interface{}{
+					"crtPEM": "certificate",
+					"keyPEM": "private key",
+				},
+			},
+		},
+		"webhooks": []interface{}{
+			map[string]interface{}{"name": "injector", "keyPEM": "private key", "externalSecret": true},
+		},
+		"grafana": map[string]interface{}{"password": ""},
+	}
+	expected := map[string]interface{}{
+		"identityTrustAnchorsPEM": "trust anchors",
+		"identity": map[string]interface{}{
+			"issuer": map[string]interface{}{
+				"tls": map[string]interface{}{
+					"crtPEM": "certificate",
+					"keyPEM": redactedValue,
+				},
+			},
+		},
+		"webhooks": []interface{}{
+			map[string]interface{}{"name": "injector", "keyPEM": redactedValue, "externalSecret": true},
+		},
+		"grafana": map[string]interface{}{"password": ""},
+	}
+
+	redactValues(values)
+	if !reflect.DeepEqual(values, expected) {
+		t.Fatalf("Expected %v, got %v", expected, values)
+	}
+}
+
+func TestSampleProxyPods(t *testing.T) {
+	pods := []corev1.Pod{}
+	for _, ns := range []string{"emojivoto", "books"} {
+		for _, name := range []string{"b", "a", "c"} {
+			pods = append(pods, corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name}})
+		}
+	}
+
+	sampled := []string{}
+	for _, pod := range sampleProxyPods(pods, 2) {
+		sampled = append(sampled, pod.Namespace+"/"+pod.Name)
+	}
+	expected := []string{"books/a", "emojivoto/a"}
+	if !reflect.DeepEqual(sampled, expected) {
+		t.Fatalf("Expected %v, got %v", expected, sampled)
+	}
+
+	if n := len(sampleProxyPods(pods, 10)); n != len(pods) {
+		t.Fatalf("Expected all %d pods, got %d", len(pods), n)
+	}
+}
+
+func TestCollectBundleParts(t *testing.T) {
+	now := time.Now()
+	k8sAPI, err := k8s.NewFakeAPI(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: linkerd-config
+  namespace: linkerd
+data:
+  values: |
+    controllerImage: ghcr.io/linkerd/controller
+    identity:
+      issuer:
+        tls:
+          crtPEM: issuer certificate
+          keyPEM: issuer private key`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	ctx := context.Background()
+	for _, event := range []corev1.Event{
+		{
+			ObjectMeta:    metav1.ObjectMeta{Namespace: "linkerd", Name: "recent"},
+			Type:          corev1.EventTypeNormal,
+			Reason:        "Started",
+			LastTimestamp: metav1.NewTime(now.Add(-time.Minute)),
+		},
+		{
+			ObjectMeta:    metav1.ObjectMeta{Namespace: "linkerd", Name: "old"},
+			Type:          corev1.EventTypeNormal,
+			Reason:        "Pulled",
+			LastTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+		},
+	} {
+		event := event // pin
+		if _, err := k8sAPI.CoreV1().Events(event.Namespace).Create(ctx, &event, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	link := &unstructured.Unstructured{}
+	link.SetAPIVersion("multicluster.linkerd.io/v1alpha1")
+	link.SetKind("Link")
+	link.SetNamespace("linkerd-multicluster")
+	link.SetName("west")
+	k8sAPI.DynamicClient = dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), link)
+
+	var buf, errBuf bytes.Buffer
+	b, gw := newBundleWriter(&buf, &errBuf)
+	if err := collectConfig(ctx, k8sAPI, b); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := collectEvents(ctx, k8sAPI, newBundleOptions(), b); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := collectLinks(ctx, k8sAPI, b); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	b.fail("proxy metrics", errors.New("no meshed pods"))
+	if err := b.close(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	files := readBundle(t, buf.Bytes())
+
+	config := files["linkerd-diagnostics/linkerd-config.yaml"]
+	if !strings.Contains(config, "crtPEM: issuer certificate") || !strings.Contains(config, "keyPEM: <redacted>") {
+		t.Fatalf("Expected the issuer key to be redacted, got:\n%s", config)
+	}
+	if strings.Contains(config, "issuer private key") {
+		t.Fatalf("Expected no private key in the config, got:\n%s", config)
+	}
+
+	events := files["linkerd-diagnostics/events/control-plane.yaml"]
+	if !strings.Contains(events, "name: recent") || strings.Contains(events, "name: old") {
+		t.Fatalf("Expected only the recent event, got:\n%s", events)
+	}
+
+	links := files["linkerd-diagnostics/links.yaml"]
+	if !strings.Contains(links, "name: west") {
+		t.Fatalf("Expected the west link, got:\n%s", links)
+	}
+
+	expectedErrors := "proxy metrics: no meshed pods\n"
+	if errs := files["linkerd-diagnostics/errors.txt"]; errs != expectedErrors {
+		t.Fatalf("Expected errors %q, got %q", expectedErrors, errs)
+	}
+	if !strings.Contains(errBuf.String(), "Failed to collect proxy metrics: no meshed pods") {
+		t.Fatalf("Expected the failure to be reported, got %q", errBuf.String())
+	}
+}
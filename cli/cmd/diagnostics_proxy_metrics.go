@@ -0,0 +1,388 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linkerd/linkerd2/cli/table"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/spf13/cobra"
+)
+
+const (
+	aggregateSum = "sum"
+	aggregateMax = "max"
+	aggregatePod = "pod"
+)
+
+// labelMatcherRegexp parses Prometheus label matchers, e.g. direction="inbound"
+// or status_code=~"5..".
+var labelMatcherRegexp = regexp.MustCompile(`^([a-zA-Z_][a-zA-Z0-9_]*)(=~|!~|!=|=)(.*)$`)
+
+type proxyMetricsOptions struct {
+	namespace    string
+	metrics      []string
+	labels       []string
+	aggregate    string
+	outputFormat string
+	wait         time.Duration
+}
+
+func newProxyMetricsOptions() *proxyMetricsOptions {
+	return &proxyMetricsOptions{
+		namespace:    defaultNamespace,
+		metrics:      []string{},
+		labels:       []string{},
+		aggregate:    aggregateSum,
+		outputFormat: tableOutput,
+		wait:         30 * time.Second,
+	}
+}
+
+func (o *proxyMetricsOptions) validate() error {
+	switch o.aggregate {
+	case aggregateSum, aggregateMax, aggregatePod:
+	default:
+		return fmt.Errorf("--aggregate must be one of %s, %s or %s", aggregateSum, aggregateMax, aggregatePod)
+	}
+	if o.outputFormat != tableOutput && o.outputFormat != jsonOutput {
+		return fmt.Errorf("--output currently only supports %s and %s", tableOutput, jsonOutput)
+	}
+	return nil
+}
+
+// metricsFilter selects the samples whose metric name matches one of names,
+// if any, and whose labels match all of matchers.
+type metricsFilter struct {
+	names    []*regexp.Regexp
+	matchers []labelMatcher
+}
+
+type labelMatcher struct {
+	name  string
+	op    string
+	value string
+	re    *regexp.Regexp
+}
+
+func (m labelMatcher) matches(labels map[string]string) bool {
+	value := labels[m.name]
+	switch m.op {
+	case "=":
+		return value == m.value
+	case "!=":
+		return value != m.value
+	case "=~":
+		return m.re.MatchString(value)
+	default: // "!~"
+		return !m.re.MatchString(value)
+	}
+}
+
+func newMetricsFilter(names, matchers []string) (*metricsFilter, error) {
+	filter := &metricsFilter{}
+	for _, name := range names {
+		re, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid metric name %q: %s", name, err)
+		}
+		filter.names = append(filter.names, re)
+	}
+	for _, matcher := range matchers {
+		parts := labelMatcherRegexp.FindStringSubmatch(matcher)
+		if parts == nil {
+			return nil, fmt.Errorf("invalid label matcher %q, expected e.g. direction=\"inbound\"", matcher)
+		}
+		m := labelMatcher{name: parts[1], op: parts[2], value: strings.Trim(parts[3], `"`)}
+		if m.op == "=~" || m.op == "!~" {
+			re, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", m.value))
+			if err != nil {
+				return nil, fmt.Errorf("invalid label matcher %q: %s", matcher, err)
+			}
+			m.re = re
+		}
+		filter.matchers = append(filter.matchers, m)
+	}
+	return filter, nil
+}
+
+func (f *metricsFilter) matches(s sample) bool {
+	if len(f.names) > 0 {
+		matched := false
+		for _, re := range f.names {
+			if re.MatchString(s.name) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	for _, m := range f.matchers {
+		if !m.matches(s.labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// sample is a single value of the exposition format, with histograms and
+// summaries flattened into their _bucket, _sum and _count series.
+type sample struct {
+	name   string
+	labels map[string]string
+	value  float64
+}
+
+// series identifies the sample across pods, e.g. request_total{direction="inbound"}.
+func (s sample) series() string {
+	names := make([]string, 0, len(s.labels))
+	for name := range s.labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	labels := make([]string, 0, len(names))
+	for _, name := range names {
+		labels = append(labels, fmt.Sprintf("%s=%q", name, s.labels[name]))
+	}
+	if len(labels) == 0 {
+		return s.name
+	}
+	return fmt.Sprintf("%s{%s}", s.name, strings.Join(labels, ","))
+}
+
+// parseSamples parses metrics in the Prometheus text exposition format.
+func parseSamples(metrics []byte) ([]sample, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(metrics))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse metrics: %s", err)
+	}
+
+	samples := []sample{}
+	for name, family := range families {
+		for _, m := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range m.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			with := func(name, value string) map[string]string {
+				l := map[string]string{name: value}
+				for k, v := range labels {
+					l[k] = v
+				}
+				return l
+			}
+
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				samples = append(samples, sample{name, labels, m.GetCounter().GetValue()})
+			case dto.MetricType_GAUGE:
+				samples = append(samples, sample{name, labels, m.GetGauge().GetValue()})
+			case dto.MetricType_HISTOGRAM:
+				h := m.GetHistogram()
+				for _, b := range h.GetBucket() {
+					le := strconv.FormatFloat(b.GetUpperBound(), 'g', -1, 64)
+					samples = append(samples, sample{name + "_bucket", with("le", le), float64(b.GetCumulativeCount())})
+				}
+				samples = append(samples,
+					sample{name + "_sum", labels, h.GetSampleSum()},
+					sample{name + "_count", labels, float64(h.GetSampleCount())})
+			case dto.MetricType_SUMMARY:
+				s := m.GetSummary()
+				for _, q := range s.GetQuantile() {
+					quantile := strconv.FormatFloat(q.GetQuantile(), 'g', -1, 64)
+					samples = append(samples, sample{name, with("quantile", quantile), q.GetValue()})
+				}
+				samples = append(samples,
+					sample{name + "_sum", labels, s.GetSampleSum()},
+					sample{name + "_count", labels, float64(s.GetSampleCount())})
+			default:
+				samples = append(samples, sample{name, labels, m.GetUntyped().GetValue()})
+			}
+		}
+	}
+	return samples, nil
+}
+
+// proxyMetricsRow is a row of the output. Pod is only set when aggregating per
+// pod, and Pods only when aggregating across pods.
+type proxyMetricsRow struct {
+	Pod    string            `json:"pod,omitempty"`
+	Series string            `json:"-"`
+	Metric string            `json:"metric"`
+	Labels map[string]string `json:"labels"`
+	Value  float64           `json:"value"`
+	Pods   int               `json:"pods,omitempty"`
+}
+
+// aggregateSamples filters the samples of each pod, and aggregates them
+// across pods by series with the given aggregation.
+func aggregateSamples(samplesByPod map[string][]sample, filter *metricsFilter, aggregate string) []*proxyMetricsRow {
+	rows := []*proxyMetricsRow{}
+	bySeries := map[string]*proxyMetricsRow{}
+	for pod, samples := range samplesByPod {
+		for _, s := range samples {
+			if !filter.matches(s) {
+				continue
+			}
+			series := s.series()
+			if aggregate == aggregatePod {
+				rows = append(rows, &proxyMetricsRow{Pod: pod, Series: series, Metric: s.name, Labels: s.labels, Value: s.value})
+				continue
+			}
+
+			row, ok := bySeries[series]
+			if !ok {
+				row = &proxyMetricsRow{Series: series, Metric: s.name, Labels: s.labels, Value: s.value, Pods: 1}
+				bySeries[series] = row
+				rows = append(rows, row)
+				continue
+			}
+			row.Pods++
+			switch aggregate {
+			case aggregateSum:
+				row.Value += s.value
+			case aggregateMax:
+				if s.value > row.Value {
+					row.Value = s.value
+				}
+			}
+		}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Series != rows[j].Series {
+			return rows[i].Series < rows[j].Series
+		}
+		return rows[i].Pod < rows[j].Pod
+	})
+	return rows
+}
+
+func renderProxyMetrics(w io.Writer, rows []*proxyMetricsRow, options *proxyMetricsOptions) error {
+	if options.outputFormat == jsonOutput {
+		out, err := json.MarshalIndent(rows, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s\n", out)
+		return nil
+	}
+
+	columns := []table.Column{
+		{Header: "SERIES", Width: 6, Flexible: true, LeftAlign: true},
+		{Header: strings.ToUpper(options.aggregate), Width: 5, Flexible: true},
+		{Header: "PODS", Width: 4},
+	}
+	if options.aggregate == aggregatePod {
+		columns = []table.Column{
+			{Header: "POD", Width: 3, Flexible: true, LeftAlign: true},
+			{Header: "SERIES", Width: 6, Flexible: true, LeftAlign: true},
+			{Header: "VALUE", Width: 5, Flexible: true},
+		}
+	}
+	data := []table.Row{}
+	for _, row := range rows {
+		value := strconv.FormatFloat(row.Value, 'g', -1, 64)
+		if options.aggregate == aggregatePod {
+			data = append(data, table.Row{row.Pod, row.Series, value})
+		} else {
+			data = append(data, table.Row{row.Series, value, strconv.Itoa(row.Pods)})
+		}
+	}
+	t := table.NewTable(columns, data)
+	t.Render(w)
+	return nil
+}
+
+func newCmdDiagnosticsProxyMetrics() *cobra.Command {
+	options := newProxyMetricsOptions()
+
+	cmd := &cobra.Command{
+		Use:   "proxy-metrics [flags] (RESOURCE)",
+		Short: "Fetch, filter and aggregate the metrics of the Linkerd proxies of a resource",
+		Long: `Fetch, filter and aggregate the metrics of the Linkerd proxies of a resource.
+
+  This command initiates port-forwards to the pods of the resource
+  concurrently, and queries the /metrics endpoint on their Linkerd proxies.
+  The series of the metrics matching the --metric and --label filters are then
+  aggregated across the pods.
+
+  The RESOURCE argument specifies the target resource to query metrics for,
+  as with the linkerd metrics command: (TYPE/NAME)
+
+  The --aggregate flag sets how the values of a series are aggregated:
+  * sum: the sum of the values of all the pods
+  * max: the highest value of all the pods
+  * pod: no aggregation, a row per pod`,
+		Example: `  # Get the total number of inbound requests of the web deployment.
+  linkerd diagnostics proxy-metrics -n emojivoto deploy/web \
+    --metric request_total --label direction=inbound
+
+  # Get the highest number of open TCP connections of each pod.
+  linkerd diagnostics proxy-metrics -n emojivoto deploy/web \
+    --metric tcp_open_connections --aggregate pod -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(); err != nil {
+				return err
+			}
+			filter, err := newMetricsFilter(options.metrics, options.labels)
+			if err != nil {
+				return err
+			}
+
+			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+			if err != nil {
+				return err
+			}
+
+			pods, err := getPodsFor(cmd.Context(), k8sAPI, options.namespace, args[0])
+			if err != nil {
+				return err
+			}
+
+			samplesByPod := map[string][]sample{}
+			for _, result := range getMetrics(k8sAPI, pods, k8s.ProxyAdminPortName, options.wait, verbose) {
+				if result.err != nil {
+					fmt.Fprintf(os.Stderr, "Failed to fetch the metrics of pod %s: %s\n", result.pod, result.err)
+					continue
+				}
+				samples, err := parseSamples(result.metrics)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Failed to parse the metrics of pod %s: %s\n", result.pod, err)
+					continue
+				}
+				samplesByPod[result.pod] = samples
+			}
+
+			rows := aggregateSamples(samplesByPod, filter, options.aggregate)
+			if len(rows) == 0 && options.outputFormat == tableOutput {
+				fmt.Fprintln(os.Stderr, "No metrics found.")
+				return nil
+			}
+			return renderProxyMetrics(os.Stdout, rows, options)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of resource")
+	cmd.Flags().StringArrayVarP(&options.metrics, "metric", "m", options.metrics, "Only include the metrics whose name matches this regular expression (can be repeated)")
+	cmd.Flags().StringArrayVarP(&options.labels, "label", "l", options.labels, "Only include the series matching this label matcher, e.g. direction=\"inbound\" or status_code=~\"5..\" (can be repeated)")
+	cmd.Flags().StringVar(&options.aggregate, "aggregate", options.aggregate, fmt.Sprintf("Aggregation of the series across pods: %s, %s or %s", aggregateSum, aggregateMax, aggregatePod))
+	cmd.Flags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, fmt.Sprintf("Output format; one of: \"%s\" or \"%s\"", tableOutput, jsonOutput))
+	cmd.Flags().DurationVarP(&options.wait, "wait", "w", options.wait, "Time allowed to fetch the metrics of the proxies")
+
+	return cmd
+}
@@ -0,0 +1,197 @@
+package cmd
+
+import (
+	"bytes"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+const proxyMetricsPod1 = `# TYPE request_total counter
+request_total{direction="inbound",authority="web.emojivoto.svc.cluster.local:80"} 10
+request_total{direction="outbound",authority="voting.emojivoto.svc.cluster.local:8080"} 4
+# TYPE tcp_open_connections gauge
+tcp_open_connections{direction="inbound",peer="src"} 3
+# TYPE response_latency_ms histogram
+response_latency_ms_bucket{direction="inbound",le="10"} 7
+response_latency_ms_bucket{direction="inbound",le="+Inf"} 10
+response_latency_ms_sum{direction="inbound"} 42
+response_latency_ms_count{direction="inbound"} 10
+`
+
+const proxyMetricsPod2 = `# TYPE request_total counter
+request_total{direction="inbound",authority="web.emojivoto.svc.cluster.local:80"} 5
+# TYPE tcp_open_connections gauge
+tcp_open_connections{direction="inbound",peer="src"} 8
+`
+
+func proxyMetricsSamples(t *testing.T) map[string][]sample {
+	t.Helper()
+	samplesByPod := map[string][]sample{}
+	for pod, metrics := range map[string]string{"web-1": proxyMetricsPod1, "web-2": proxyMetricsPod2} {
+		samples, err := parseSamples([]byte(metrics))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		samplesByPod[pod] = samples
+	}
+	return samplesByPod
+}
+
+func TestAggregateSamples(t *testing.T) {
+	type result struct {
+		pod    string
+		series string
+		value  float64
+		pods   int
+	}
+
+	testCases := []struct {
+		name      string
+		metrics   []string
+		labels    []string
+		aggregate string
+		expected  []result
+	}{
+		{
+			"sum",
+			[]string{"request_total"},
+			[]string{`direction="inbound"`},
+			aggregateSum,
+			[]result{
+				{"", `request_total{authority="web.emojivoto.svc.cluster.local:80",direction="inbound"}`, 15, 2},
+			},
+		},
+		{
+			"max",
+			[]string{"tcp_.*"},
+			nil,
+			aggregateMax,
+			[]result{
+				{"", `tcp_open_connections{direction="inbound",peer="src"}`, 8, 2},
+			},
+		},
+		{
+			"pod",
+			[]string{"tcp_open_connections"},
+			nil,
+			aggregatePod,
+			[]result{
+				{"web-1", `tcp_open_connections{direction="inbound",peer="src"}`, 3, 0},
+				{"web-2", `tcp_open_connections{direction="inbound",peer="src"}`, 8, 0},
+			},
+		},
+		{
+			"histogram",
+			[]string{"response_latency_ms_.*"},
+			[]string{`le!~"10|20"`},
+			aggregateSum,
+			[]result{
+				{"", `response_latency_ms_bucket{direction="inbound",le="+Inf"}`, 10, 1},
+				{"", `response_latency_ms_count{direction="inbound"}`, 10, 1},
+				{"", `response_latency_ms_sum{direction="inbound"}`, 42, 1},
+			},
+		},
+		{
+			"regexp label",
+			nil,
+			[]string{`authority=~"voting.*"`},
+			aggregateSum,
+			[]result{
+				{"", `request_total{authority="voting.emojivoto.svc.cluster.local:8080",direction="outbound"}`, 4, 1},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := newMetricsFilter(tc.metrics, tc.labels)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			results := []result{}
+			for _, row := range aggregateSamples(proxyMetricsSamples(t), filter, tc.aggregate) {
+				results = append(results, result{row.Pod, row.Series, row.Value, row.Pods})
+			}
+			if !reflect.DeepEqual(results, tc.expected) {
+				t.Fatalf("Expected %v, got %v", tc.expected, results)
+			}
+		})
+	}
+}
+
+func TestNewMetricsFilterErrors(t *testing.T) {
+	if _, err := newMetricsFilter([]string{"request_total("}, nil); err == nil {
+		t.Fatal("Expected an error for an invalid metric name")
+	}
+	if _, err := newMetricsFilter(nil, []string{"direction"}); err == nil {
+		t.Fatal("Expected an error for an invalid label matcher")
+	}
+}
+
+func TestRenderProxyMetrics(t *testing.T) {
+	filter, err := newMetricsFilter([]string{"tcp_open_connections"}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		aggregate    string
+		outputFormat string
+		expected     string
+	}{
+		{
+			aggregateMax,
+			tableOutput,
+			`SERIES                                                  MAX  PODS
+tcp_open_connections{direction="inbound",peer="src"}      8     2
+`,
+		},
+		{
+			aggregatePod,
+			tableOutput,
+			`POD    SERIES                                                VALUE
+web-1  tcp_open_connections{direction="inbound",peer="src"}      3
+web-2  tcp_open_connections{direction="inbound",peer="src"}      8
+`,
+		},
+		{
+			aggregateSum,
+			jsonOutput,
+			`[
+  {
+    "metric": "tcp_open_connections",
+    "labels": {
+      "direction": "inbound",
+      "peer": "src"
+    },
+    "value": 11,
+    "pods": 2
+  }
+]
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.aggregate+"-"+tc.outputFormat, func(t *testing.T) {
+			options := newProxyMetricsOptions()
+			options.aggregate = tc.aggregate
+			options.outputFormat = tc.outputFormat
+
+			var buf bytes.Buffer
+			rows := aggregateSamples(proxyMetricsSamples(t), filter, options.aggregate)
+			if err := renderProxyMetrics(&buf, rows, options); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			// The table pads the last column.
+			actual := regexp.MustCompile(" +\n").ReplaceAllString(buf.String(), "\n")
+			if actual != tc.expected {
+				t.Fatalf("Expected:\n%s\nGot:\n%s", tc.expected, actual)
+			}
+		})
+	}
+}
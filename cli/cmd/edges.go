@@ -11,14 +11,26 @@ import (
 	"text/tabwriter"
 
 	"github.com/linkerd/linkerd2/controller/api/util"
+	authz "github.com/linkerd/linkerd2/controller/gen/apis/policy/v1alpha1"
+	spclient "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	idctl "github.com/linkerd/linkerd2/controller/identity"
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/policy"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
 type edgesOptions struct {
 	namespace     string
 	outputFormat  string
 	allNamespaces bool
+	startTime     string
+	endTime       string
 }
 
 func newEdgesOptions() *edgesOptions {
@@ -63,7 +75,13 @@ func newCmdEdges() *cobra.Command {
   * pods
   * replicasets
   * replicationcontrollers
-  * statefulsets`,
+  * statefulsets
+
+  The wide and JSON outputs also report whether the ServerAuthorizations
+  selecting the destination's pods authorize the client on any of their ports.
+
+  The edges of a past time range are queried with "--start" and "--at", which
+  take RFC 3339 times, as with the stat and routes commands.`,
 		Example: `  # Get all edges between pods that either originate from or terminate in the demo namespace.
   linkerd edges po -n test
 
@@ -71,7 +89,10 @@ func newCmdEdges() *cobra.Command {
   linkerd edges po
 
   # Get all edges between pods in all namespaces.
-  linkerd edges po --all-namespaces`,
+  linkerd edges po --all-namespaces
+
+  # Get all edges between pods in the test namespace seen between 12:00 and 12:30 UTC on October 1st 2020.
+  linkerd edges po -n test --start 2020-10-01T12:00:00Z --at 2020-10-01T12:30:00Z`,
 		Args:      cobra.ExactArgs(1),
 		ValidArgs: util.ValidTargets,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -104,7 +125,34 @@ func newCmdEdges() *cobra.Command {
 				}
 			}
 
-			output := renderEdgeStats(totalRows, options)
+			// The table output doesn't show authorizations nor SPIFFE IDs,
+			// so it doesn't need access to the Kubernetes API
+			var authorizations edgeAuthorizations
+			var domain *idctl.TrustDomain
+			if options.outputFormat != tableOutput {
+				k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+				if err != nil {
+					return err
+				}
+				authzClient, err := spclient.NewForConfig(k8sAPI.Config)
+				if err != nil {
+					return err
+				}
+				trustDomain, err := fetchTrustDomain(cmd.Context(), k8sAPI)
+				if err != nil {
+					return err
+				}
+				domain, err = idctl.NewTrustDomain(controlPlaneNamespace, trustDomain)
+				if err != nil {
+					return err
+				}
+				authorizations, err = authorizeEdges(cmd.Context(), k8sAPI, authzClient, trustDomain, totalRows)
+				if err != nil {
+					return err
+				}
+			}
+
+			output := renderEdgeStats(totalRows, authorizations, domain, options)
 			_, err = fmt.Print(output)
 
 			return err
@@ -114,6 +162,8 @@ func newCmdEdges() *cobra.Command {
 	cmd.PersistentFlags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the specified resource")
 	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, "Output format; one of: \"table\" or \"json\" or \"wide\"")
 	cmd.PersistentFlags().BoolVarP(&options.allNamespaces, "all-namespaces", "A", options.allNamespaces, "If present, returns edges across all namespaces, ignoring the \"--namespace\" flag")
+	cmd.PersistentFlags().StringVar(&options.endTime, "at", options.endTime, "If present, returns the edges at the given RFC 3339 time (for example: \"2020-10-01T12:00:00Z\") rather than now")
+	cmd.PersistentFlags().StringVar(&options.startTime, "start", options.startTime, "If present, returns the edges seen from the given RFC 3339 time to the \"--at\" time, or now")
 	return cmd
 }
 
@@ -155,6 +205,8 @@ func buildEdgesRequests(resources []string, options *edgesOptions) ([]*pb.EdgesR
 			ResourceType:  target.Type,
 			Namespace:     options.namespace,
 			AllNamespaces: options.allNamespaces,
+			StartTime:     options.startTime,
+			EndTime:       options.endTime,
 		}
 
 		req, err := util.BuildEdgesRequest(requestParams)
@@ -185,10 +237,10 @@ func requestEdgesFromAPI(client pb.ApiClient, req *pb.EdgesRequest) (*pb.EdgesRe
 	return resp, nil
 }
 
-func renderEdgeStats(rows []*pb.Edge, options *edgesOptions) string {
+func renderEdgeStats(rows []*pb.Edge, authorizations edgeAuthorizations, domain *idctl.TrustDomain, options *edgesOptions) string {
 	var buffer bytes.Buffer
 	w := tabwriter.NewWriter(&buffer, 0, 0, padding, ' ', tabwriter.AlignRight)
-	writeEdgesToBuffer(rows, w, options)
+	writeEdgesToBuffer(rows, authorizations, domain, w, options)
 	w.Flush()
 
 	return renderEdges(buffer, options)
@@ -201,6 +253,9 @@ type edgeRow struct {
 	dstNamespace string
 	client       string
 	server       string
+	clientSpiffe string
+	serverSpiffe string
+	authz        string
 	msg          string
 }
 
@@ -211,16 +266,22 @@ const (
 	dstNamespaceHeader = "DST_NS"
 	clientHeader       = "CLIENT_ID"
 	serverHeader       = "SERVER_ID"
+	clientSpiffeHeader = "CLIENT_SPIFFE_ID"
+	serverSpiffeHeader = "SERVER_SPIFFE_ID"
+	authzHeader        = "AUTHZ"
 	msgHeader          = "SECURED"
 )
 
-func writeEdgesToBuffer(rows []*pb.Edge, w *tabwriter.Writer, options *edgesOptions) {
+func writeEdgesToBuffer(rows []*pb.Edge, authorizations edgeAuthorizations, domain *idctl.TrustDomain, w *tabwriter.Writer, options *edgesOptions) {
 	maxSrcLength := len(srcHeader)
 	maxDstLength := len(dstHeader)
 	maxSrcNamespaceLength := len(srcNamespaceHeader)
 	maxDstNamespaceLength := len(dstNamespaceHeader)
 	maxClientLength := len(clientHeader)
 	maxServerLength := len(serverHeader)
+	maxClientSpiffeLength := len(clientSpiffeHeader)
+	maxServerSpiffeLength := len(serverSpiffeHeader)
+	maxAuthzLength := len(authzHeader)
 	maxMsgLength := len(msgHeader)
 
 	edgeRows := []edgeRow{}
@@ -232,6 +293,12 @@ func writeEdgesToBuffer(rows []*pb.Edge, w *tabwriter.Writer, options *edgesOpti
 			if len(msg) == 0 && options.outputFormat != jsonOutput {
 				msg = okStatus
 			}
+			clientSpiffe := spiffeID(domain, clientID)
+			serverSpiffe := spiffeID(domain, serverID)
+			authzStatus := authorizations[r]
+			if len(authzStatus) == 0 && options.outputFormat != jsonOutput {
+				authzStatus = "-"
+			}
 			if len(clientID) > 0 {
 				parts := strings.Split(clientID, ".")
 				clientID = parts[0] + "." + parts[1]
@@ -244,6 +311,9 @@ func writeEdgesToBuffer(rows []*pb.Edge, w *tabwriter.Writer, options *edgesOpti
 			row := edgeRow{
 				client:       clientID,
 				server:       serverID,
+				clientSpiffe: clientSpiffe,
+				serverSpiffe: serverSpiffe,
+				authz:        authzStatus,
 				msg:          msg,
 				src:          r.Src.Name,
 				srcNamespace: r.Src.Namespace,
@@ -271,6 +341,15 @@ func writeEdgesToBuffer(rows []*pb.Edge, w *tabwriter.Writer, options *edgesOpti
 			if len(serverID) > maxServerLength {
 				maxServerLength = len(serverID)
 			}
+			if len(clientSpiffe) > maxClientSpiffeLength {
+				maxClientSpiffeLength = len(clientSpiffe)
+			}
+			if len(serverSpiffe) > maxServerSpiffeLength {
+				maxServerSpiffeLength = len(serverSpiffe)
+			}
+			if len(authzStatus) > maxAuthzLength {
+				maxAuthzLength = len(authzStatus)
+			}
 			if len(msg) > maxMsgLength {
 				maxMsgLength = len(msg)
 			}
@@ -290,13 +369,13 @@ func writeEdgesToBuffer(rows []*pb.Edge, w *tabwriter.Writer, options *edgesOpti
 			fmt.Fprintln(os.Stderr, "No edges found.")
 			os.Exit(0)
 		}
-		printEdgeTable(edgeRows, w, maxSrcLength, maxSrcNamespaceLength, maxDstLength, maxDstNamespaceLength, maxClientLength, maxServerLength, maxMsgLength, options.outputFormat)
+		printEdgeTable(edgeRows, w, maxSrcLength, maxSrcNamespaceLength, maxDstLength, maxDstNamespaceLength, maxClientLength, maxServerLength, maxClientSpiffeLength, maxServerSpiffeLength, maxAuthzLength, maxMsgLength, options.outputFormat)
 	case jsonOutput:
 		printEdgesJSON(edgeRows, w)
 	}
 }
 
-func printEdgeTable(edgeRows []edgeRow, w *tabwriter.Writer, maxSrcLength, maxSrcNamespaceLength, maxDstLength, maxDstNamespaceLength, maxClientLength, maxServerLength, maxMsgLength int, outputFormat string) {
+func printEdgeTable(edgeRows []edgeRow, w *tabwriter.Writer, maxSrcLength, maxSrcNamespaceLength, maxDstLength, maxDstNamespaceLength, maxClientLength, maxServerLength, maxClientSpiffeLength, maxServerSpiffeLength, maxAuthzLength, maxMsgLength int, outputFormat string) {
 	srcTemplate := fmt.Sprintf("%%-%ds", maxSrcLength)
 	dstTemplate := fmt.Sprintf("%%-%ds", maxDstLength)
 	srcNamespaceTemplate := fmt.Sprintf("%%-%ds", maxSrcNamespaceLength)
@@ -304,6 +383,9 @@ func printEdgeTable(edgeRows []edgeRow, w *tabwriter.Writer, maxSrcLength, maxSr
 	msgTemplate := fmt.Sprintf("%%-%ds", maxMsgLength)
 	clientTemplate := fmt.Sprintf("%%-%ds", maxClientLength)
 	serverTemplate := fmt.Sprintf("%%-%ds", maxServerLength)
+	clientSpiffeTemplate := fmt.Sprintf("%%-%ds", maxClientSpiffeLength)
+	serverSpiffeTemplate := fmt.Sprintf("%%-%ds", maxServerSpiffeLength)
+	authzTemplate := fmt.Sprintf("%%-%ds", maxAuthzLength)
 
 	headers := []string{
 		fmt.Sprintf(srcTemplate, srcHeader),
@@ -313,7 +395,13 @@ func printEdgeTable(edgeRows []edgeRow, w *tabwriter.Writer, maxSrcLength, maxSr
 	}
 
 	if outputFormat == wideOutput {
-		headers = append(headers, fmt.Sprintf(clientTemplate, clientHeader), fmt.Sprintf(serverTemplate, serverHeader))
+		headers = append(headers,
+			fmt.Sprintf(clientTemplate, clientHeader),
+			fmt.Sprintf(serverTemplate, serverHeader),
+			fmt.Sprintf(clientSpiffeTemplate, clientSpiffeHeader),
+			fmt.Sprintf(serverSpiffeTemplate, serverSpiffeHeader),
+			fmt.Sprintf(authzTemplate, authzHeader),
+		)
 	}
 
 	headers = append(headers, fmt.Sprintf(msgTemplate, msgHeader)+"\t")
@@ -330,8 +418,8 @@ func printEdgeTable(edgeRows []edgeRow, w *tabwriter.Writer, maxSrcLength, maxSr
 		templateString := fmt.Sprintf("%s\t%s\t%s\t%s\t", srcTemplate, dstTemplate, srcNamespaceTemplate, dstNamespaceTemplate)
 
 		if outputFormat == wideOutput {
-			templateString += fmt.Sprintf("%s\t%s\t", clientTemplate, serverTemplate)
-			values = append(values, row.client, row.server)
+			templateString += fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t", clientTemplate, serverTemplate, clientSpiffeTemplate, serverSpiffeTemplate, authzTemplate)
+			values = append(values, row.client, row.server, row.clientSpiffe, row.serverSpiffe, row.authz)
 		}
 
 		templateString += fmt.Sprintf("%s\t\n", msgTemplate)
@@ -362,6 +450,9 @@ type edgesJSONStats struct {
 	DstNamespace string `json:"dst_namespace"`
 	Client       string `json:"client_id"`
 	Server       string `json:"server_id"`
+	ClientSpiffe string `json:"client_spiffe_id"`
+	ServerSpiffe string `json:"server_spiffe_id"`
+	Authz        string `json:"authorization"`
 	Msg          string `json:"no_tls_reason"`
 }
 
@@ -377,6 +468,9 @@ func printEdgesJSON(edgeRows []edgeRow, w *tabwriter.Writer) {
 			DstNamespace: row.dstNamespace,
 			Client:       row.client,
 			Server:       row.server,
+			ClientSpiffe: row.clientSpiffe,
+			ServerSpiffe: row.serverSpiffe,
+			Authz:        row.authz,
 			Msg:          row.msg}
 		entries = append(entries, entry)
 	}
@@ -388,3 +482,125 @@ func printEdgesJSON(edgeRows []edgeRow, w *tabwriter.Writer) {
 	}
 	fmt.Fprintf(w, "%s\n", b)
 }
+
+// spiffeID returns the SPIFFE ID of a DNS-form identity, or an empty string if
+// the identity doesn't name a service account of the trust domain, or if the
+// trust domain isn't known.
+func spiffeID(domain *idctl.TrustDomain, id string) string {
+	if domain == nil {
+		return ""
+	}
+	u, err := domain.IdentitySpiffeID(id)
+	if err != nil {
+		return ""
+	}
+	return u.String()
+}
+
+const (
+	edgeAllowed = "allowed"
+	edgeDenied  = "denied"
+)
+
+// edgeAuthorizations tells, for each edge, whether the ServerAuthorizations of
+// its destination allow its client to connect. Edges whose destination can't
+// be resolved are absent.
+type edgeAuthorizations map[*pb.Edge]string
+
+// serverAuthorizationsLister returns the ServerAuthorizations of a namespace
+type serverAuthorizationsLister func(ctx context.Context, namespace string) ([]*authz.ServerAuthorization, error)
+
+// listServerAuthorizations returns a serverAuthorizationsLister listing the
+// ServerAuthorizations installed in the cluster
+func listServerAuthorizations(authzClient spclient.Interface) serverAuthorizationsLister {
+	return func(ctx context.Context, namespace string) ([]*authz.ServerAuthorization, error) {
+		list, err := authzClient.PolicyV1alpha1().ServerAuthorizations(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		serverAuthzs := []*authz.ServerAuthorization{}
+		for i := range list.Items {
+			serverAuthzs = append(serverAuthzs, &list.Items[i])
+		}
+		return serverAuthzs, nil
+	}
+}
+
+// authorizeEdges evaluates the ServerAuthorizations selecting the destination
+// pods of the edges against their client identities. Edges don't carry the
+// destination port, so a client is allowed if it's authorized on any port of
+// every destination pod.
+func authorizeEdges(ctx context.Context, k kubernetes.Interface, authzClient spclient.Interface, trustDomain string, rows []*pb.Edge) (edgeAuthorizations, error) {
+	authorizations, err := evaluateEdges(ctx, k, listServerAuthorizations(authzClient), trustDomain, rows)
+	if kerrors.IsNotFound(err) {
+		// the ServerAuthorization CRD hasn't been installed
+		return edgeAuthorizations{}, nil
+	}
+	return authorizations, err
+}
+
+// fetchTrustDomain returns the trust domain of the identities issued by the
+// control plane, which service accounts and namespaces are resolved into.
+func fetchTrustDomain(ctx context.Context, k kubernetes.Interface) (string, error) {
+	_, values, err := healthcheck.FetchCurrentConfiguration(ctx, k, controlPlaneNamespace)
+	if err != nil {
+		return "", fmt.Errorf("could not fetch configs from kubernetes: %s", err)
+	}
+	return values.Global.IdentityTrustDomain, nil
+}
+
+// evaluateEdges evaluates the edges against the ServerAuthorizations returned
+// by listAuthzs for their destination namespaces, resolving the service
+// accounts and namespaces they authorize into identities of trustDomain
+func evaluateEdges(ctx context.Context, k kubernetes.Interface, listAuthzs serverAuthorizationsLister, trustDomain string, rows []*pb.Edge) (edgeAuthorizations, error) {
+	authorizations := edgeAuthorizations{}
+	serverAuthzs := map[string][]*authz.ServerAuthorization{}
+	pods := map[string][]corev1.Pod{}
+
+	for _, r := range rows {
+		ns := r.Dst.Namespace
+		if _, ok := serverAuthzs[ns]; !ok {
+			list, err := listAuthzs(ctx, ns)
+			if err != nil {
+				return nil, err
+			}
+			serverAuthzs[ns] = list
+		}
+
+		resource := fmt.Sprintf("%s/%s", r.Dst.Type, r.Dst.Name)
+		key := ns + "/" + resource
+		if _, ok := pods[key]; !ok {
+			dstPods, err := getPodsFor(ctx, k, ns, resource)
+			if err != nil && !kerrors.IsNotFound(err) {
+				return nil, err
+			}
+			pods[key] = dstPods
+		}
+		if len(pods[key]) == 0 {
+			continue
+		}
+
+		authorizations[r] = edgeAllowed
+		for i := range pods[key] {
+			if !authorizedOnAnyPort(serverAuthzs[ns], &pods[key][i], r.ClientId, trustDomain) {
+				authorizations[r] = edgeDenied
+				break
+			}
+		}
+	}
+	return authorizations, nil
+}
+
+func authorizedOnAnyPort(serverAuthzs []*authz.ServerAuthorization, pod *corev1.Pod, clientID, trustDomain string) bool {
+	selected := false
+	for _, serverAuthz := range serverAuthzs {
+		if !policy.SelectsPod(serverAuthz, pod) {
+			continue
+		}
+		if policy.Authorizes(serverAuthz, clientID, controlPlaneNamespace, trustDomain) {
+			return true
+		}
+		selected = true
+	}
+	return !selected
+}
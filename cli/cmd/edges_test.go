@@ -1,11 +1,86 @@
 package cmd
 
 import (
+	"context"
 	"testing"
 
 	"github.com/linkerd/linkerd2/controller/api/public"
+	idctl "github.com/linkerd/linkerd2/controller/identity"
+	"github.com/linkerd/linkerd2/pkg/k8s"
 )
 
+// The emoji deployment only accepts the web service account, while the voting
+// deployment only accepts the linkerd namespace.
+var edgesAuthorizationConfigs = []string{`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: linkerd-config
+  namespace: linkerd
+data:
+  values: |
+    global:
+      identityTrustDomain: cluster.local`, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: emoji
+  namespace: emojivoto
+spec:
+  selector:
+    matchLabels:
+      app: emoji-svc`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emoji-1
+  namespace: emojivoto
+  labels:
+    app: emoji-svc`, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: voting
+  namespace: emojivoto
+spec:
+  selector:
+    matchLabels:
+      app: voting-svc`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: voting-1
+  namespace: emojivoto
+  labels:
+    app: voting-svc`, `
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: emoji
+  namespace: emojivoto
+spec:
+  podSelector:
+    matchLabels:
+      app: emoji-svc
+  client:
+    meshTLS:
+      serviceAccounts:
+      - name: web`, `
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: voting
+  namespace: emojivoto
+spec:
+  podSelector:
+    matchLabels:
+      app: voting-svc
+  client:
+    meshTLS:
+      namespaces:
+      - linkerd`,
+}
+
 type edgesParamsExp struct {
 	options      *edgesOptions
 	resourceType string
@@ -116,7 +191,24 @@ func testEdgesCall(exp edgesParamsExp, t *testing.T) {
 	}
 
 	rows := edgesRespToRows(resp)
-	output := renderEdgeStats(rows, exp.options)
+
+	k, _, _, authzClient, _, err := k8s.NewFakeClientSets(edgesAuthorizationConfigs...)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	trustDomain, err := fetchTrustDomain(context.Background(), k)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	domain, err := idctl.NewTrustDomain(controlPlaneNamespace, trustDomain)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	authorizations, err := authorizeEdges(context.Background(), k, authzClient, trustDomain, rows)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	output := renderEdgeStats(rows, authorizations, domain, exp.options)
 
 	diffTestdata(t, exp.file, output)
 }
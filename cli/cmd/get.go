@@ -27,18 +27,25 @@ func newCmdGet() *cobra.Command {
 	options := newGetOptions()
 
 	cmd := &cobra.Command{
-		Use:   "get [flags] pods",
+		Use:   "get [flags] (pods|drift)",
 		Short: "Display one or many mesh resources",
 		Long: `Display one or many mesh resources.
 
-Only pod resources (aka pods, po) are supported.`,
+Only pod resources (aka pods, po) are supported.
+
+The drift argument lists the meshed pods whose proxy configuration differs
+from what injecting their workload with the current linkerd-config would
+produce, such as pods injected before an upgrade that haven't been restarted.`,
 		Example: `  # get all pods
   linkerd get pods
 
   # get pods from namespace linkerd
-  linkerd get pods --namespace linkerd`,
+  linkerd get pods --namespace linkerd
+
+  # get the pods of all namespaces whose proxy configuration drifted
+  linkerd get drift --all-namespaces`,
 		Args:      cobra.ExactArgs(1),
-		ValidArgs: []string{k8s.Pod},
+		ValidArgs: []string{k8s.Pod, driftArg},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) < 1 {
 				return errors.New("please specify a resource type")
@@ -49,6 +56,10 @@ Only pod resources (aka pods, po) are supported.`,
 			}
 
 			friendlyName := args[0]
+			if friendlyName == driftArg {
+				return getDrift(cmd.Context(), os.Stdout, options)
+			}
+
 			resourceType, err := k8s.CanonicalResourceNameFromFriendlyName(friendlyName)
 
 			if err != nil || resourceType != k8s.Pod {
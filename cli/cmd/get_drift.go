@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/linkerd/linkerd2/cli/table"
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+)
+
+const driftArg = "drift"
+
+func getDrift(ctx context.Context, w io.Writer, options *getOptions) error {
+	k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+	if err != nil {
+		return err
+	}
+
+	namespace := options.namespace
+	if options.allNamespaces {
+		namespace = ""
+	}
+	drifted, err := healthcheck.GetProxyDrift(ctx, k8sAPI, controlPlaneNamespace, namespace)
+	if err != nil {
+		return err
+	}
+
+	if len(drifted) == 0 {
+		fmt.Fprintln(os.Stderr, "No drifted pods found.")
+		return nil
+	}
+	renderDrift(w, drifted)
+	return nil
+}
+
+// renderDrift writes a row for each drifted field of each pod.
+func renderDrift(w io.Writer, drifted []healthcheck.PodDrift) {
+	columns := []table.Column{
+		{Header: "NAMESPACE", Width: 9, Flexible: true, LeftAlign: true},
+		{Header: "POD", Width: 3, Flexible: true, LeftAlign: true},
+		{Header: "FIELD", Width: 5, Flexible: true, LeftAlign: true},
+		{Header: "CURRENT", Width: 7, Flexible: true, LeftAlign: true},
+		{Header: "EXPECTED", Width: 8, Flexible: true, LeftAlign: true},
+	}
+	rows := []table.Row{}
+	for _, pod := range drifted {
+		for _, d := range pod.Drift {
+			rows = append(rows, table.Row{pod.Namespace, pod.Name, d.Field, d.Current, d.Expected})
+		}
+	}
+	t := table.NewTable(columns, rows)
+	t.Render(w)
+}
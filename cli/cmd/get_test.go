@@ -1,11 +1,15 @@
 package cmd
 
 import (
+	"bytes"
 	"errors"
+	"regexp"
 	"testing"
 
 	"github.com/linkerd/linkerd2/controller/api/public"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/inject"
 )
 
 func TestGetPods(t *testing.T) {
@@ -68,3 +72,28 @@ func TestGetPods(t *testing.T) {
 		}
 	})
 }
+
+func TestRenderDrift(t *testing.T) {
+	drifted := []healthcheck.PodDrift{
+		{
+			Namespace: "emojivoto",
+			Name:      "web-5d8f9-x2k4p",
+			Drift: []inject.Drift{
+				{Field: "proxy image", Current: "ghcr.io/linkerd/proxy:stable-2.9.0", Expected: "ghcr.io/linkerd/proxy:stable-2.9.1"},
+				{Field: "log level", Current: "warn,linkerd=info", Expected: "warn,linkerd=debug"},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	renderDrift(&buf, drifted)
+	expected := `NAMESPACE  POD              FIELD        CURRENT                             EXPECTED
+emojivoto  web-5d8f9-x2k4p  proxy image  ghcr.io/linkerd/proxy:stable-2.9.0  ghcr.io/linkerd/proxy:stable-2.9.1
+emojivoto  web-5d8f9-x2k4p  log level    warn,linkerd=info                   warn,linkerd=debug
+`
+	// The table pads the last column.
+	actual := regexp.MustCompile(" +\n").ReplaceAllString(buf.String(), "\n")
+	if actual != expected {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, actual)
+	}
+}
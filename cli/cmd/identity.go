@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// newCmdIdentity creates a new cobra command `identity` which contains
+// subcommands to manage the certificates backing Linkerd's mTLS identities
+func newCmdIdentity() *cobra.Command {
+	identityCmd := &cobra.Command{
+		Use:   "identity",
+		Short: "Manage the trust anchors, issuer certificate and workload identities of the mesh",
+		Args:  cobra.NoArgs,
+	}
+
+	identityCmd.AddCommand(newCmdIdentityCerts())
+	identityCmd.AddCommand(newCmdIdentityJoinToken())
+	identityCmd.AddCommand(newCmdIdentityRotate())
+
+	return identityCmd
+}
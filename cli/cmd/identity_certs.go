@@ -0,0 +1,470 @@
+package cmd
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	cryptotls "crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/issuercerts"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	certKindTrustAnchor = "trust-anchor"
+	certKindIssuer      = "issuer"
+	certKindWebhook     = "webhook"
+	certKindAPIService  = "apiservice"
+	certKindProxy       = "proxy"
+
+	certValidOK = "ok"
+
+	// maxProxyCertFetches bounds the number of proxies whose certificate is
+	// fetched at once, each through its own port-forward.
+	maxProxyCertFetches = 10
+)
+
+type (
+	identityCertsOptions struct {
+		outputFormat string
+		namespace    string
+		proxies      bool
+		wait         time.Duration
+
+		// proxyCert returns the certificate chain presented by the proxy of a
+		// pod for the given server name. It can be overridden for tests.
+		proxyCert func(k *k8s.KubernetesAPI, pod corev1.Pod, serverName string) ([]*x509.Certificate, error)
+	}
+
+	// proxyCertResult holds the certificate chain presented by the proxy of a
+	// pod, or the error encountered while fetching it.
+	proxyCertResult struct {
+		pod   string
+		chain []*x509.Certificate
+		err   error
+	}
+
+	// certRow describes one of the certificates backing the mesh's identity.
+	certRow struct {
+		Kind      string     `json:"kind"`
+		Name      string     `json:"name"`
+		Subject   string     `json:"subject"`
+		SANs      []string   `json:"sans"`
+		Algorithm string     `json:"algorithm,omitempty"`
+		NotBefore *time.Time `json:"notBefore,omitempty"`
+		NotAfter  *time.Time `json:"notAfter,omitempty"`
+		Valid     bool       `json:"valid"`
+		Error     string     `json:"error,omitempty"`
+	}
+)
+
+func newIdentityCertsOptions() *identityCertsOptions {
+	return &identityCertsOptions{
+		outputFormat: tableOutput,
+		proxies:      true,
+		wait:         30 * time.Second,
+		proxyCert:    getProxyCertificate,
+	}
+}
+
+func (options *identityCertsOptions) validate() error {
+	if options.outputFormat == tableOutput || options.outputFormat == jsonOutput {
+		return nil
+	}
+
+	return fmt.Errorf("--output currently only supports %s and %s", tableOutput, jsonOutput)
+}
+
+// newCmdIdentityCerts creates a new cobra command `identity certs` that lists
+// the certificates backing the mesh's identity
+func newCmdIdentityCerts() *cobra.Command {
+	options := newIdentityCertsOptions()
+
+	cmd := &cobra.Command{
+		Use:   "certs [flags]",
+		Args:  cobra.NoArgs,
+		Short: "List the certificates backing the mesh's identity",
+		Long: `List the certificates backing the mesh's identity.
+
+This command lists the trust anchors, the issuer certificate, the serving
+certificates of the control plane webhooks and tap APIService and, for every
+meshed pod, the leaf certificate of its proxy. Each certificate is verified
+against the trust roots it's expected to chain up to.
+
+Proxy leaf certificates are fetched through a port-forward to the proxies'
+inbound port, by starting a TLS handshake for the identity of their pod.`,
+		Example: `  # List all the certificates, including the ones of every meshed pod
+  linkerd identity certs
+
+  # Only inspect the proxies in the emojivoto namespace, in json format
+  linkerd identity certs -n emojivoto -o json
+
+  # Skip the proxies altogether
+  linkerd identity certs --proxies=false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(); err != nil {
+				return err
+			}
+
+			k, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+			if err != nil {
+				return err
+			}
+
+			rows, err := options.fetchCerts(cmd.Context(), k)
+			if err != nil {
+				return err
+			}
+			return renderCerts(rows, options.outputFormat, os.Stdout)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, fmt.Sprintf("Output format; one of: \"%s\" or \"%s\"", tableOutput, jsonOutput))
+	cmd.Flags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Only list the proxy certificates of pods in this namespace (all namespaces by default)")
+	cmd.Flags().BoolVar(&options.proxies, "proxies", options.proxies, "List the leaf certificates of the meshed pods' proxies")
+	cmd.Flags().DurationVar(&options.wait, "wait", options.wait, "Maximum time to wait for the proxies' certificates")
+
+	return cmd
+}
+
+// fetchCerts gathers the certificates of the mesh. Certificates that can't be
+// retrieved are reported as invalid rather than failing the whole listing.
+func (options *identityCertsOptions) fetchCerts(ctx context.Context, k *k8s.KubernetesAPI) ([]certRow, error) {
+	_, values, err := healthcheck.FetchCurrentConfiguration(ctx, k, controlPlaneNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch configs from kubernetes: %s", err)
+	}
+	if values.Global.IdentityTrustAnchorsPEM == "" {
+		return nil, fmt.Errorf("identity is not configured in the control plane")
+	}
+
+	anchors, err := tls.DecodePEMCertificates(values.Global.IdentityTrustAnchorsPEM)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode the trust anchors: %s", err)
+	}
+
+	var rows []certRow
+	for _, anchor := range anchors {
+		row := newCertRow(certKindTrustAnchor, anchor.Subject.CommonName, anchor)
+		row.setValidity(issuercerts.CheckCertValidityPeriod(anchor))
+		rows = append(rows, row)
+	}
+
+	issuerName := fmt.Sprintf("identity.%s.%s", controlPlaneNamespace, values.Global.IdentityTrustDomain)
+	var issuerData *issuercerts.IssuerCertData
+	if values.Identity.Issuer.Scheme == "" || values.Identity.Issuer.Scheme == k8s.IdentityIssuerSchemeLinkerd {
+		issuerData, err = issuercerts.FetchIssuerData(ctx, k, values.Global.IdentityTrustAnchorsPEM, controlPlaneNamespace)
+	} else {
+		issuerData, err = issuercerts.FetchExternalIssuerData(ctx, k, controlPlaneNamespace)
+	}
+	rows = append(rows, credRow(certKindIssuer, k8s.IdentityIssuerSecretName, err, func() (*tls.Cred, []*x509.Certificate, error) {
+		cred, err := tls.ValidateAndCreateCreds(issuerData.IssuerCrt, issuerData.IssuerKey)
+		return cred, anchors, err
+	}, issuerName))
+
+	webhooks := []struct {
+		kind     string
+		name     string
+		caBundle func() ([]*x509.Certificate, error)
+	}{
+		{certKindAPIService, k8s.TapServiceName, func() ([]*x509.Certificate, error) { return healthcheck.FetchTapCaBundle(ctx, k) }},
+		{certKindWebhook, k8s.ProxyInjectorWebhookServiceName, func() ([]*x509.Certificate, error) { return healthcheck.FetchProxyInjectorCaBundle(ctx, k) }},
+		{certKindWebhook, k8s.SPValidatorWebhookServiceName, func() ([]*x509.Certificate, error) { return healthcheck.FetchSpValidatorCaBundle(ctx, k) }},
+	}
+	for _, wh := range webhooks {
+		wh := wh // pin
+		rows = append(rows, credRow(wh.kind, wh.name, nil, func() (*tls.Cred, []*x509.Certificate, error) {
+			caBundle, err := wh.caBundle()
+			if err != nil {
+				return nil, nil, err
+			}
+			cred, err := healthcheck.FetchWebhookCreds(ctx, k, controlPlaneNamespace, wh.name)
+			return cred, caBundle, err
+		}, fmt.Sprintf("%s.%s.svc", wh.name, controlPlaneNamespace)))
+	}
+
+	if options.proxies {
+		proxyRows, err := options.fetchProxyCerts(ctx, k, values.Global.IdentityTrustDomain, values.Global.IdentityTrustAnchorsPEM)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, proxyRows...)
+	}
+
+	return rows, nil
+}
+
+// credRow builds the row of a certificate that's verified against the given
+// roots for the given DNS name. fetchErr short-circuits the fetching of the
+// credentials.
+func credRow(kind, name string, fetchErr error, fetch func() (*tls.Cred, []*x509.Certificate, error), dnsName string) certRow {
+	row := certRow{Kind: kind, Name: name}
+	if fetchErr != nil {
+		row.setValidity(fetchErr)
+		return row
+	}
+
+	cred, roots, err := fetch()
+	if err != nil {
+		row.setValidity(err)
+		return row
+	}
+
+	row = newCertRow(kind, name, cred.Certificate)
+	row.setValidity(cred.Verify(tls.CertificatesToPool(roots), dnsName, time.Now()))
+	return row
+}
+
+// fetchProxyCerts builds the rows of the meshed pods' leaf certificates, as
+// presented by their proxies. A proxy is deemed valid when its certificate
+// chains up to the currently configured trust anchors for the identity of its
+// pod, and it trusts these anchors itself.
+func (options *identityCertsOptions) fetchProxyCerts(ctx context.Context, k *k8s.KubernetesAPI, trustDomain, trustAnchors string) ([]certRow, error) {
+	selector := fmt.Sprintf("%s=%s", k8s.ControllerNSLabel, controlPlaneNamespace)
+	podList, err := k.CoreV1().Pods(options.namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	pods := []corev1.Pod{}
+	for _, pod := range podList.Items {
+		if pod.Status.Phase == corev1.PodRunning {
+			pods = append(pods, pod)
+		}
+	}
+
+	meshedPods, err := healthcheck.GetMeshedPodsIdentityData(ctx, k, options.namespace)
+	if err != nil {
+		return nil, err
+	}
+	podAnchors := map[string]string{}
+	for _, pod := range meshedPods {
+		podAnchors[pod.Namespace+"/"+pod.Name] = pod.Anchors
+	}
+
+	roots, err := tls.DecodePEMCertPool(trustAnchors)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode the trust anchors: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, options.wait)
+	defer cancel()
+
+	// At most maxProxyCertFetches certificates are fetched at once, and no
+	// more fetches are started once the wait is over. The results are
+	// buffered so that the fetches still in flight then don't block.
+	results := make(chan proxyCertResult, len(pods))
+	go func() {
+		sem := make(chan struct{}, maxProxyCertFetches)
+		for _, pod := range pods {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			go func(pod corev1.Pod) {
+				defer func() { <-sem }()
+				chain, err := options.proxyCert(k, pod, proxyIdentityName(pod, trustDomain))
+				results <- proxyCertResult{pod: pod.Namespace + "/" + pod.Name, chain: chain, err: err}
+			}(pod)
+		}
+	}()
+
+	byPod := map[string]proxyCertResult{}
+wait:
+	for range pods {
+		select {
+		case result := <-results:
+			byPod[result.pod] = result
+		case <-ctx.Done():
+			break wait
+		}
+	}
+
+	var rows []certRow
+	for _, pod := range pods {
+		name := pod.Namespace + "/" + pod.Name
+		result, ok := byPod[name]
+		switch {
+		case !ok:
+			row := certRow{Kind: certKindProxy, Name: name}
+			row.setValidity(fmt.Errorf("timed out fetching the certificate"))
+			rows = append(rows, row)
+		case result.err != nil:
+			row := certRow{Kind: certKindProxy, Name: name}
+			row.setValidity(fmt.Errorf("could not fetch the certificate: %s", result.err))
+			rows = append(rows, row)
+		default:
+			crt := tls.Crt{Certificate: result.chain[0], TrustChain: result.chain[1:]}
+			row := newCertRow(certKindProxy, name, crt.Certificate)
+			if anchors, ok := podAnchors[name]; ok && strings.TrimSpace(anchors) != strings.TrimSpace(trustAnchors) {
+				row.setValidity(fmt.Errorf("does not have the current trust anchors and must be restarted"))
+			} else {
+				row.setValidity(crt.Verify(roots, proxyIdentityName(pod, trustDomain), time.Now()))
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Name < rows[j].Name })
+	return rows, nil
+}
+
+// proxyIdentityName returns the identity the proxy of pod is issued a
+// certificate for.
+func proxyIdentityName(pod corev1.Pod, trustDomain string) string {
+	sa := pod.Spec.ServiceAccountName
+	if sa == "" {
+		sa = "default"
+	}
+	return fmt.Sprintf("%s.%s.serviceaccount.identity.%s.%s", sa, pod.Namespace, controlPlaneNamespace, trustDomain)
+}
+
+// getProxyCertificate returns the certificate chain presented by the proxy of
+// pod, by starting a TLS handshake for serverName on its inbound port through
+// a port-forward. The chain isn't verified here.
+func getProxyCertificate(k *k8s.KubernetesAPI, pod corev1.Pod, serverName string) ([]*x509.Certificate, error) {
+	var proxy *corev1.Container
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == k8s.ProxyContainerName {
+			proxy = &pod.Spec.Containers[i]
+		}
+	}
+	if proxy == nil {
+		return nil, fmt.Errorf("no %s container found", k8s.ProxyContainerName)
+	}
+
+	portForward, err := k8s.NewContainerMetricsForward(k, pod, *proxy, verbose, k8s.ProxyPortName)
+	if err != nil {
+		return nil, err
+	}
+	defer portForward.Stop()
+	if err := portForward.Init(); err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	conn, err := cryptotls.DialWithDialer(dialer, "tcp", portForward.AddressAndPort(), &cryptotls.Config{
+		ServerName: serverName,
+		// the chain is verified against the trust anchors by the caller,
+		// so that verification errors can be reported
+		InsecureSkipVerify: true, // #nosec G402
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	chain := conn.ConnectionState().PeerCertificates
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("no certificate presented")
+	}
+	return chain, nil
+}
+
+func newCertRow(kind, name string, cert *x509.Certificate) certRow {
+	sans := append([]string{}, cert.DNSNames...)
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+
+	notBefore, notAfter := cert.NotBefore.UTC(), cert.NotAfter.UTC()
+	return certRow{
+		Kind:      kind,
+		Name:      name,
+		Subject:   cert.Subject.CommonName,
+		SANs:      sans,
+		Algorithm: certAlgorithm(cert),
+		NotBefore: &notBefore,
+		NotAfter:  &notAfter,
+	}
+}
+
+func (row *certRow) setValidity(err error) {
+	row.Valid = err == nil
+	if err != nil {
+		row.Error = err.Error()
+	}
+}
+
+// certAlgorithm describes the public key of a certificate, e.g. "ECDSA P-256"
+func certAlgorithm(cert *x509.Certificate) string {
+	switch key := cert.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		return fmt.Sprintf("ECDSA %s", key.Curve.Params().Name)
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA %d", key.N.BitLen())
+	case ed25519.PublicKey:
+		return "Ed25519"
+	default:
+		return cert.PublicKeyAlgorithm.String()
+	}
+}
+
+func renderCerts(rows []certRow, outputFormat string, w io.Writer) error {
+	if outputFormat == jsonOutput {
+		if rows == nil {
+			rows = []certRow{}
+		}
+		b, err := json.MarshalIndent(rows, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", b)
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, padding, ' ', 0)
+	fmt.Fprintln(tw, strings.Join([]string{"KIND", "NAME", "SUBJECT", "SANS", "ALGORITHM", "NOT BEFORE", "NOT AFTER", "VALID"}, "\t"))
+	for _, row := range rows {
+		valid := certValidOK
+		if !row.Valid {
+			valid = row.Error
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			row.Kind,
+			row.Name,
+			orDash(row.Subject),
+			orDash(strings.Join(row.SANs, ",")),
+			orDash(row.Algorithm),
+			formatCertTime(row.NotBefore),
+			formatCertTime(row.NotAfter),
+			valid,
+		)
+	}
+	return tw.Flush()
+}
+
+func formatCertTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
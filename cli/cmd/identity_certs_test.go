@@ -0,0 +1,227 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/issuercerts"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestIdentityCerts(t *testing.T) {
+	installOpts, installFlags, _, _ := testOptionsAndFlags(t)
+	installBuf := renderInstall(t, installValues(t, installOpts, installFlags))
+	install := installBuf.String()
+
+	k := applyRotation(t, nil, install)
+	anchors := configuredAnchors(t, k)
+	manifests := []string{
+		install,
+		meshedPod("web", anchors, time.Now()),
+		meshedPod("stale", "-----BEGIN CERTIFICATE-----", time.Now()),
+		meshedPod("pending", anchors, time.Now()),
+		meshedPod("impostor", anchors, time.Now()),
+		strings.Replace(meshedPod("completed", anchors, time.Now()), "phase: Running", "phase: Succeeded", 1),
+		strings.Replace(meshedPod("other-control-plane", anchors, time.Now()), "control-plane-ns: linkerd", "control-plane-ns: other", 1),
+	}
+	// more pods than maxProxyCertFetches, so that some wait for a fetch
+	for i := 0; i < 2*maxProxyCertFetches; i++ {
+		manifests = append(manifests, meshedPod(fmt.Sprintf("replica-%d", i), anchors, time.Now()))
+	}
+	k = applyRotation(t, nil, manifests...)
+
+	issuerData, err := issuercerts.FetchIssuerData(context.Background(), k, anchors, controlPlaneNamespace)
+	if err != nil {
+		t.Fatalf("Unexpected error fetching the issuer: %s", err)
+	}
+	issuerCred, err := tls.ValidateAndCreateCreds(issuerData.IssuerCrt, issuerData.IssuerKey)
+	if err != nil {
+		t.Fatalf("Unexpected error reading the issuer: %s", err)
+	}
+	ca := tls.NewCA(*issuerCred, tls.Validity{Lifetime: 24 * time.Hour})
+
+	// the proxies' certificates are fetched concurrently
+	var mu sync.Mutex
+	issued := map[string]*x509.Certificate{}
+	inFlight, maxInFlight := 0, 0
+	options := newIdentityCertsOptions()
+	options.proxyCert = func(_ *k8s.KubernetesAPI, pod corev1.Pod, serverName string) ([]*x509.Certificate, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(time.Millisecond)
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		switch pod.Name {
+		case "pending":
+			return nil, errors.New("connection refused")
+		case "impostor":
+			serverName = "other.emojivoto.serviceaccount.identity.linkerd.cluster.local"
+		}
+		cred, err := ca.GenerateEndEntityCred(serverName)
+		if err != nil {
+			return nil, err
+		}
+		mu.Lock()
+		issued[pod.Name] = cred.Certificate
+		mu.Unlock()
+		return append([]*x509.Certificate{cred.Certificate}, cred.TrustChain...), nil
+	}
+
+	rows, err := options.fetchCerts(context.Background(), k)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	byName := map[string]certRow{}
+	for _, row := range rows {
+		byName[row.Name] = row
+	}
+	if maxInFlight > maxProxyCertFetches {
+		t.Errorf("Expected at most %d certificates to be fetched at once, got %d", maxProxyCertFetches, maxInFlight)
+	}
+	for _, name := range []string{"emojivoto/completed", "emojivoto/other-control-plane"} {
+		if _, ok := byName[name]; ok {
+			t.Errorf("Expected no row for %s", name)
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		kind      string
+		valid     bool
+		algorithm string
+	}{
+		{k8s.IdentityIssuerSecretName, certKindIssuer, true, "ECDSA P-256"},
+		{k8s.TapServiceName, certKindAPIService, true, "RSA 2048"},
+		{k8s.ProxyInjectorWebhookServiceName, certKindWebhook, true, "RSA 2048"},
+		{k8s.SPValidatorWebhookServiceName, certKindWebhook, true, "RSA 2048"},
+		{"emojivoto/web", certKindProxy, true, "ECDSA P-256"},
+		{"emojivoto/stale", certKindProxy, false, "ECDSA P-256"},
+		{"emojivoto/pending", certKindProxy, false, ""},
+		{"emojivoto/impostor", certKindProxy, false, "ECDSA P-256"},
+	}
+
+	for _, tc := range testCases {
+		row, ok := byName[tc.name]
+		if !ok {
+			t.Fatalf("Expected a row for %s, got: %+v", tc.name, rows)
+		}
+		if row.Kind != tc.kind {
+			t.Errorf("Expected %s to be of kind %s, got %s", tc.name, tc.kind, row.Kind)
+		}
+		if row.Valid != tc.valid {
+			t.Errorf("Expected %s validity to be %t, got %t (%s)", tc.name, tc.valid, row.Valid, row.Error)
+		}
+		if row.Algorithm != tc.algorithm {
+			t.Errorf("Expected %s algorithm to be %q, got %q", tc.name, tc.algorithm, row.Algorithm)
+		}
+	}
+
+	web := byName["emojivoto/web"]
+	expectedIdentity := "default.emojivoto.serviceaccount.identity.linkerd.cluster.local"
+	if web.Subject != expectedIdentity {
+		t.Errorf("Expected the proxy certificate subject to be %s, got %s", expectedIdentity, web.Subject)
+	}
+	if len(web.SANs) != 1 || web.SANs[0] != expectedIdentity {
+		t.Errorf("Expected the proxy certificate SANs to be [%s], got %v", expectedIdentity, web.SANs)
+	}
+	if web.NotAfter == nil || !web.NotAfter.Equal(issued["web"].NotAfter) {
+		t.Errorf("Expected the proxy certificate to expire at %s, got %v", issued["web"].NotAfter, web.NotAfter)
+	}
+
+	var anchorRows int
+	for _, row := range rows {
+		if row.Kind == certKindTrustAnchor {
+			anchorRows++
+			if !row.Valid {
+				t.Errorf("Expected trust anchor %s to be valid: %s", row.Name, row.Error)
+			}
+		}
+	}
+	if anchorRows != 1 {
+		t.Errorf("Expected 1 trust anchor, got %d", anchorRows)
+	}
+
+	var out bytes.Buffer
+	if err := renderCerts(rows, jsonOutput, &out); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	var decoded []certRow
+	if err := json.Unmarshal(out.Bytes(), &decoded); err != nil {
+		t.Fatalf("Unexpected error decoding json output: %s", err)
+	}
+	if len(decoded) != len(rows) {
+		t.Errorf("Expected %d rows in json output, got %d", len(rows), len(decoded))
+	}
+
+	out.Reset()
+	if err := renderCerts(rows, tableOutput, &out); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(out.String()), "\n"); len(lines) != len(rows)+1 {
+		t.Errorf("Expected %d lines in table output, got %d:\n%s", len(rows)+1, len(lines), out.String())
+	}
+}
+
+func TestIdentityCertsWait(t *testing.T) {
+	installOpts, installFlags, _, _ := testOptionsAndFlags(t)
+	installBuf := renderInstall(t, installValues(t, installOpts, installFlags))
+	install := installBuf.String()
+
+	k := applyRotation(t, nil, install)
+	anchors := configuredAnchors(t, k)
+	manifests := []string{install}
+	for i := 0; i < 2*maxProxyCertFetches; i++ {
+		manifests = append(manifests, meshedPod(fmt.Sprintf("replica-%d", i), anchors, time.Now()))
+	}
+	k = applyRotation(t, nil, manifests...)
+
+	// the fetches hang until the wait is over
+	unblock := make(chan struct{})
+	defer close(unblock)
+	var mu sync.Mutex
+	fetches := 0
+	options := newIdentityCertsOptions()
+	options.wait = 100 * time.Millisecond
+	options.proxyCert = func(*k8s.KubernetesAPI, corev1.Pod, string) ([]*x509.Certificate, error) {
+		mu.Lock()
+		fetches++
+		mu.Unlock()
+		<-unblock
+		return nil, errors.New("canceled")
+	}
+
+	rows, err := options.fetchCerts(context.Background(), k)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if fetches > maxProxyCertFetches {
+		t.Errorf("Expected at most %d fetches to be started, got %d", maxProxyCertFetches, fetches)
+	}
+	for _, row := range rows {
+		if row.Kind == certKindProxy && row.Error != "timed out fetching the certificate" {
+			t.Errorf("Expected %s to time out, got %q", row.Name, row.Error)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/identity"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const joinKeyLength = 32
+
+type identityJoinTokenOptions struct {
+	namespace string
+	ttl       time.Duration
+}
+
+func newIdentityJoinTokenOptions() *identityJoinTokenOptions {
+	return &identityJoinTokenOptions{
+		namespace: corev1.NamespaceDefault,
+		ttl:       time.Hour,
+	}
+}
+
+// newCmdIdentityJoinToken creates a new cobra command `identity join-token`
+// that issues join tokens for external workloads
+func newCmdIdentityJoinToken() *cobra.Command {
+	options := newIdentityJoinTokenOptions()
+
+	cmd := &cobra.Command{
+		Use:   "join-token [flags] EXTERNAL-WORKLOAD",
+		Args:  cobra.ExactArgs(1),
+		Short: "Issue a join token for an external workload",
+		Long: `Issue a join token for an external workload.
+
+External workloads, such as VMs, are described by ExternalWorkload resources.
+Their proxy presents the join token to the identity controller to be issued a
+certificate for the service account of their ExternalWorkload, until the token
+expires.
+
+Join tokens are signed with the key stored in the linkerd-identity-join-key
+secret, which is created the first time this command is run. The identity
+controller must then be restarted to pick the key up.`,
+		Example: `  # Issue a join token for the vm-1 ExternalWorkload, valid for a day.
+  linkerd identity join-token -n emojivoto --ttl 24h vm-1`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			k, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+			if err != nil {
+				return err
+			}
+
+			return options.run(cmd.Context(), k, args[0], os.Stdout, os.Stderr)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the external workload")
+	cmd.Flags().DurationVar(&options.ttl, "ttl", options.ttl, "How long the join token is valid for")
+
+	return cmd
+}
+
+// run writes a join token for the named external workload to w, and progress
+// messages to status.
+func (options *identityJoinTokenOptions) run(ctx context.Context, k kubernetes.Interface, name string, w, status io.Writer) error {
+	if options.ttl <= 0 {
+		return fmt.Errorf("--ttl must be positive, got %s", options.ttl)
+	}
+
+	key, created, err := fetchOrCreateJoinKey(ctx, k)
+	if err != nil {
+		return err
+	}
+	if created {
+		fmt.Fprintf(status, "Created the %s secret; restart the identity controller for it to accept join tokens:\n", k8s.IdentityJoinKeySecretName)
+		fmt.Fprintf(status, "  kubectl -n %s rollout restart deploy/linkerd-identity\n", controlPlaneNamespace)
+	}
+
+	token, err := identity.NewJoinToken(key, options.namespace, name, time.Now().Add(options.ttl))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, token)
+	return err
+}
+
+// fetchOrCreateJoinKey returns the join key stored in the control plane
+// namespace, generating it if it doesn't exist yet.
+func fetchOrCreateJoinKey(ctx context.Context, k kubernetes.Interface) ([]byte, bool, error) {
+	secret, err := k.CoreV1().Secrets(controlPlaneNamespace).Get(ctx, k8s.IdentityJoinKeySecretName, metav1.GetOptions{})
+	if err == nil {
+		key := secret.Data[k8s.IdentityJoinKeyName]
+		if len(key) == 0 {
+			return nil, false, fmt.Errorf("the %s secret has no %s entry", k8s.IdentityJoinKeySecretName, k8s.IdentityJoinKeyName)
+		}
+		return key, false, nil
+	}
+	if !kerrors.IsNotFound(err) {
+		return nil, false, err
+	}
+
+	key := make([]byte, joinKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return nil, false, err
+	}
+	secret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      k8s.IdentityJoinKeySecretName,
+			Namespace: controlPlaneNamespace,
+			Labels: map[string]string{
+				k8s.ControllerComponentLabel: "identity",
+				k8s.ControllerNSLabel:        controlPlaneNamespace,
+			},
+		},
+		Data: map[string][]byte{k8s.IdentityJoinKeyName: key},
+	}
+	if _, err := k.CoreV1().Secrets(controlPlaneNamespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		return nil, false, fmt.Errorf("could not create the %s secret: %s", k8s.IdentityJoinKeySecretName, err)
+	}
+	return key, true, nil
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIdentityJoinToken(t *testing.T) {
+	ctx := context.Background()
+	k, err := k8s.NewFakeAPI()
+	if err != nil {
+		t.Fatalf("could not initialize fake k8s API: %s", err)
+	}
+	options := newIdentityJoinTokenOptions()
+	options.namespace = "emojivoto"
+
+	t.Run("Creates the join key the first time", func(t *testing.T) {
+		var out, status bytes.Buffer
+		if err := options.run(ctx, k, "vm-1", &out, &status); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !strings.HasPrefix(out.String(), "join.") {
+			t.Fatalf("Expected a join token, got %q", out.String())
+		}
+		if !strings.Contains(status.String(), "rollout restart") {
+			t.Fatalf("Expected a restart hint, got %q", status.String())
+		}
+
+		secret, err := k.CoreV1().Secrets(controlPlaneNamespace).Get(ctx, k8s.IdentityJoinKeySecretName, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Expected the join key secret to be created: %s", err)
+		}
+		if len(secret.Data[k8s.IdentityJoinKeyName]) != joinKeyLength {
+			t.Fatalf("Expected a %d bytes join key, got %d", joinKeyLength, len(secret.Data[k8s.IdentityJoinKeyName]))
+		}
+	})
+
+	t.Run("Reuses the existing join key", func(t *testing.T) {
+		var out, status bytes.Buffer
+		if err := options.run(ctx, k, "vm-1", &out, &status); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if status.Len() != 0 {
+			t.Fatalf("Expected no status output, got %q", status.String())
+		}
+	})
+
+	t.Run("Rejects non-positive TTLs", func(t *testing.T) {
+		options := newIdentityJoinTokenOptions()
+		options.ttl = -time.Minute
+		var out, status bytes.Buffer
+		if err := options.run(ctx, k, "vm-1", &out, &status); err == nil {
+			t.Fatal("Expected an error")
+		}
+	})
+}
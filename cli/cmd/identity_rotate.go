@@ -0,0 +1,453 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/issuercerts"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// rotationPhase is the phase a trust anchor rotation has reached. Each phase
+// is entered once the manifests of the previous one have been applied and
+// all the proxies have caught up with them.
+type rotationPhase string
+
+const (
+	// rotationPhaseBundleAnchors distributes a bundle of the old and new
+	// trust anchors to the control plane and proxies.
+	rotationPhaseBundleAnchors rotationPhase = "bundle-anchors"
+
+	// rotationPhaseSwapIssuer replaces the issuer with one signed by the new
+	// trust anchor.
+	rotationPhaseSwapIssuer rotationPhase = "swap-issuer"
+
+	// rotationPhaseRemoveOldAnchors drops the old trust anchors from the
+	// bundle.
+	rotationPhaseRemoveOldAnchors rotationPhase = "remove-old-anchors"
+
+	rotationOldAnchorsKey = "old-anchors.pem"
+	rotationNewAnchorKey  = "new-anchor.pem"
+)
+
+type (
+	identityRotateOptions struct {
+		force bool
+
+		// newUpgradeOptions builds the options used to render the upgrade
+		// manifests of each phase. It can be overridden for tests.
+		newUpgradeOptions func() (*upgradeOptions, error)
+	}
+
+	// rotationState is the progress of a rotation, persisted in the
+	// linkerd-identity-rotation secret. The new trust anchor's private key is
+	// discarded as soon as the new issuer has been signed.
+	rotationState struct {
+		phase           rotationPhase
+		oldAnchors      string
+		newAnchor       string
+		issuerCrt       string
+		issuerKey       string
+		issuerSwappedAt *time.Time
+	}
+)
+
+func newIdentityRotateOptions() *identityRotateOptions {
+	return &identityRotateOptions{
+		newUpgradeOptions: newUpgradeOptionsWithDefaults,
+	}
+}
+
+// newCmdIdentityRotate creates a new cobra command `identity rotate` that
+// drives the rotation of the trust anchors and issuer certificate
+func newCmdIdentityRotate() *cobra.Command {
+	options := newIdentityRotateOptions()
+
+	cmd := &cobra.Command{
+		Use:   "rotate [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Rotate the trust anchors and issuer certificate of the mesh",
+		Long: `Rotate the trust anchors and issuer certificate of the mesh.
+
+The rotation happens in three phases:
+
+  1. a new trust anchor is generated and bundled with the current ones
+  2. the issuer is replaced by a new one, signed by the new trust anchor
+  3. the old trust anchors are removed from the bundle
+
+Each run of this command outputs the manifests of the current phase, to be
+applied with kubectl. Meshed workloads must then be restarted so that their
+proxies pick up the new trust anchors. Running the command again verifies that
+all the proxies have caught up before moving on to the next phase. Progress is
+recorded in the linkerd-identity-rotation secret, so an interrupted rotation
+continues where it left off.
+
+This command is not supported when the issuer certificate is managed by an
+external solution such as cert-manager.`,
+		Example: `  # Run until the rotation completes, restarting meshed workloads in between.
+  linkerd identity rotate | kubectl apply -f -
+  kubectl -n emojivoto rollout restart deploy`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			k, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+			if err != nil {
+				return err
+			}
+
+			// rendering to a buffer so that nothing is printed on stdout
+			// unless the whole phase succeeds
+			var buf bytes.Buffer
+			if err := options.run(cmd.Context(), k, &buf, os.Stderr); err != nil {
+				return err
+			}
+			_, err = buf.WriteTo(os.Stdout)
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.force, "force", options.force,
+		"Move on to the next phase even when some proxies haven't caught up with the current one (this will likely prevent those pods from sending or receiving traffic)")
+
+	return cmd
+}
+
+// run performs as many rotation phases as the state of the cluster allows,
+// writing the manifests that must be applied to complete the current phase to
+// w, and progress messages to status.
+func (options *identityRotateOptions) run(ctx context.Context, k *k8s.KubernetesAPI, w, status io.Writer) error {
+	_, configs, err := healthcheck.FetchLinkerdConfigMap(ctx, k, controlPlaneNamespace)
+	if err != nil {
+		return fmt.Errorf("could not fetch configs from kubernetes: %s", err)
+	}
+	idctx := configs.GetGlobal().GetIdentityContext()
+	if idctx.GetTrustAnchorsPem() == "" {
+		return errors.New("identity is not configured in the control plane")
+	}
+	if idctx.GetScheme() == string(corev1.SecretTypeTLS) {
+		return errors.New("cannot rotate the trust anchors if you are using an external cert management solution; rotate them in the external solution instead")
+	}
+	configuredAnchors := idctx.GetTrustAnchorsPem()
+
+	state, err := fetchRotationState(ctx, k)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return fmt.Errorf("could not fetch rotation state: %s", err)
+		}
+		state, err = startRotation(ctx, k, configuredAnchors, idctx.GetTrustDomain())
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(status, "%s Generated a new trust anchor and issuer certificate\n", okStatus)
+	}
+
+	newAnchor, err := tls.DecodePEMCrt(state.newAnchor)
+	if err != nil {
+		return fmt.Errorf("invalid new trust anchor in %s: %s", k8s.IdentityRotationSecretName, err)
+	}
+	bundle := strings.TrimSpace(state.oldAnchors) + "\n" + strings.TrimSpace(state.newAnchor)
+
+	for {
+		switch state.phase {
+		case rotationPhaseBundleAnchors:
+			if !anchorsInclude(configuredAnchors, newAnchor.Certificate) {
+				fmt.Fprintf(status, "Phase 1/3: bundling the new trust anchor with the current ones.\nApply the manifests below, restart all meshed workloads, then run this command again.\n\n")
+				return options.renderUpgrade(ctx, k, bundle, nil, w)
+			}
+
+			meshedPods, err := healthcheck.GetMeshedPodsIdentityData(ctx, k, "")
+			if err != nil {
+				return err
+			}
+			var pending []string
+			for _, pod := range meshedPods {
+				if !anchorsInclude(pod.Anchors, newAnchor.Certificate) {
+					pending = append(pending, fmt.Sprintf("* %s/%s", pod.Namespace, pod.Name))
+				}
+			}
+			if err := options.checkPending(pending, "do not have the new trust anchor yet", status); err != nil {
+				return err
+			}
+
+			if !options.force {
+				issuer, err := tls.ValidateAndCreateCreds(state.issuerCrt, state.issuerKey)
+				if err != nil {
+					return fmt.Errorf("invalid new issuer in %s: %s", k8s.IdentityRotationSecretName, err)
+				}
+				if err := ensureIssuerCertWorksWithAllProxies(ctx, k, issuer); err != nil {
+					return err
+				}
+			}
+
+			fmt.Fprintf(status, "%s All proxies have the new trust anchor\n", okStatus)
+			state.phase = rotationPhaseSwapIssuer
+
+		case rotationPhaseSwapIssuer:
+			issuerData, err := issuercerts.FetchIssuerData(ctx, k, configuredAnchors, controlPlaneNamespace)
+			if err != nil {
+				return err
+			}
+			if strings.TrimSpace(issuerData.IssuerCrt) != strings.TrimSpace(state.issuerCrt) {
+				fmt.Fprintf(status, "Phase 2/3: replacing the issuer with one signed by the new trust anchor.\nApply the manifests below, then run this command again to list the workloads to restart.\n\n")
+				if err := updateRotationState(ctx, k, state); err != nil {
+					return err
+				}
+				return options.renderUpgrade(ctx, k, bundle, state, w)
+			}
+
+			if state.issuerSwappedAt == nil {
+				now := time.Now().UTC()
+				state.issuerSwappedAt = &now
+				if err := updateRotationState(ctx, k, state); err != nil {
+					return err
+				}
+			}
+
+			// Proxies renew their certificate well before it expires, so
+			// after a full issuance lifetime none of them can still be
+			// using a certificate signed by the old issuer.
+			lifetime := time.Duration(idctx.GetIssuanceLifetime().GetSeconds()) * time.Second
+			var pending []string
+			if time.Now().Before(state.issuerSwappedAt.Add(lifetime)) {
+				pending, err = podsCreatedBefore(ctx, k, *state.issuerSwappedAt)
+				if err != nil {
+					return err
+				}
+			}
+			if err := options.checkPending(pending, fmt.Sprintf("may still use a certificate signed by the old issuer until %s", state.issuerSwappedAt.Add(lifetime).Format(time.RFC3339)), status); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(status, "%s All proxies use certificates signed by the new issuer\n", okStatus)
+			state.phase = rotationPhaseRemoveOldAnchors
+
+		case rotationPhaseRemoveOldAnchors:
+			if !anchorsOnly(configuredAnchors, newAnchor.Certificate) {
+				fmt.Fprintf(status, "Phase 3/3: removing the old trust anchors.\nApply the manifests below, restart all meshed workloads, then run this command again.\n\n")
+				if err := updateRotationState(ctx, k, state); err != nil {
+					return err
+				}
+				return options.renderUpgrade(ctx, k, state.newAnchor, state, w)
+			}
+
+			meshedPods, err := healthcheck.GetMeshedPodsIdentityData(ctx, k, "")
+			if err != nil {
+				return err
+			}
+			var pending []string
+			for _, pod := range meshedPods {
+				if !anchorsOnly(pod.Anchors, newAnchor.Certificate) {
+					pending = append(pending, fmt.Sprintf("* %s/%s", pod.Namespace, pod.Name))
+				}
+			}
+			if err := options.checkPending(pending, "still trust the old trust anchors", status); err != nil {
+				return err
+			}
+
+			err = k.CoreV1().Secrets(controlPlaneNamespace).Delete(ctx, k8s.IdentityRotationSecretName, metav1.DeleteOptions{})
+			if err != nil && !kerrors.IsNotFound(err) {
+				return err
+			}
+			fmt.Fprintf(status, "%s Trust anchor rotation complete\n", okStatus)
+			return nil
+
+		default:
+			return fmt.Errorf("unknown rotation phase %q in %s", state.phase, k8s.IdentityRotationSecretName)
+		}
+	}
+}
+
+// checkPending fails if some pods haven't caught up with the current phase,
+// unless --force is set.
+func (options *identityRotateOptions) checkPending(pods []string, reason string, status io.Writer) error {
+	if len(pods) == 0 {
+		return nil
+	}
+	msg := fmt.Sprintf("The following pods %s:\n\t%s", reason, strings.Join(pods, "\n\t"))
+	if options.force {
+		fmt.Fprintf(status, "%s %s\nProceeding anyway because of --force\n", warnStatus, msg)
+		return nil
+	}
+	return fmt.Errorf("%s\nRestart them and run this command again. Use the --force flag to proceed anyway (this will likely prevent those pods from sending or receiving traffic)", msg)
+}
+
+// renderUpgrade writes the upgrade manifests configuring the given trust
+// anchors and, if state is set, the new issuer.
+func (options *identityRotateOptions) renderUpgrade(ctx context.Context, k *k8s.KubernetesAPI, anchors string, state *rotationState, w io.Writer) error {
+	upgradeOptions, err := options.newUpgradeOptions()
+	if err != nil {
+		return err
+	}
+	// The pods have already been checked by the rotation phases
+	upgradeOptions.force = true
+	flags := upgradeOptions.recordableFlagSet()
+
+	dir, err := ioutil.TempDir("", "linkerd-identity-rotate-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{"identity-trust-anchors-file": anchors}
+	if state != nil {
+		files["identity-issuer-certificate-file"] = state.issuerCrt
+		files["identity-issuer-key-file"] = state.issuerKey
+	}
+	for flag, content := range files {
+		path := filepath.Join(dir, flag)
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			return err
+		}
+		if err := flags.Set(flag, path); err != nil {
+			return err
+		}
+	}
+
+	values, err := upgradeOptions.validateAndBuild(ctx, "", k, flags)
+	if err != nil {
+		return fmt.Errorf("failed to build upgrade configuration: %s", err)
+	}
+	return render(w, values)
+}
+
+// startRotation generates a new trust anchor and an issuer signed by it, and
+// records them in the linkerd-identity-rotation secret.
+func startRotation(ctx context.Context, k kubernetes.Interface, currentAnchors, trustDomain string) (*rotationState, error) {
+	root, err := tls.GenerateRootCAWithDefaults(fmt.Sprintf("root.%s.%s", controlPlaneNamespace, trustDomain))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate trust anchor: %s", err)
+	}
+	issuer, err := root.GenerateCA(fmt.Sprintf("identity.%s.%s", controlPlaneNamespace, trustDomain), 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate issuer certificate: %s", err)
+	}
+
+	state := &rotationState{
+		phase:      rotationPhaseBundleAnchors,
+		oldAnchors: currentAnchors,
+		newAnchor:  root.Cred.Crt.EncodeCertificatePEM(),
+		issuerCrt:  issuer.Cred.Crt.EncodeCertificatePEM(),
+		issuerKey:  issuer.Cred.EncodePrivateKeyPEM(),
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      k8s.IdentityRotationSecretName,
+			Namespace: controlPlaneNamespace,
+		},
+	}
+	state.encode(secret)
+	if _, err := k.CoreV1().Secrets(controlPlaneNamespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to record rotation state: %s", err)
+	}
+	return state, nil
+}
+
+func fetchRotationState(ctx context.Context, k kubernetes.Interface) (*rotationState, error) {
+	secret, err := k.CoreV1().Secrets(controlPlaneNamespace).Get(ctx, k8s.IdentityRotationSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	state := &rotationState{
+		phase:      rotationPhase(secret.Annotations[k8s.IdentityRotationPhaseAnnotation]),
+		oldAnchors: string(secret.Data[rotationOldAnchorsKey]),
+		newAnchor:  string(secret.Data[rotationNewAnchorKey]),
+		issuerCrt:  string(secret.Data[k8s.IdentityIssuerCrtName]),
+		issuerKey:  string(secret.Data[k8s.IdentityIssuerKeyName]),
+	}
+	if swapped := secret.Annotations[k8s.IdentityRotationIssuerSwappedAnnotation]; swapped != "" {
+		t, err := time.Parse(time.RFC3339, swapped)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s annotation: %s", k8s.IdentityRotationIssuerSwappedAnnotation, err)
+		}
+		state.issuerSwappedAt = &t
+	}
+	return state, nil
+}
+
+func updateRotationState(ctx context.Context, k kubernetes.Interface, state *rotationState) error {
+	secret, err := k.CoreV1().Secrets(controlPlaneNamespace).Get(ctx, k8s.IdentityRotationSecretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	state.encode(secret)
+	if _, err := k.CoreV1().Secrets(controlPlaneNamespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to record rotation state: %s", err)
+	}
+	return nil
+}
+
+func (state *rotationState) encode(secret *corev1.Secret) {
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[k8s.IdentityRotationPhaseAnnotation] = string(state.phase)
+	if state.issuerSwappedAt != nil {
+		secret.Annotations[k8s.IdentityRotationIssuerSwappedAnnotation] = state.issuerSwappedAt.Format(time.RFC3339)
+	}
+	secret.Data = map[string][]byte{
+		rotationOldAnchorsKey:     []byte(state.oldAnchors),
+		rotationNewAnchorKey:      []byte(state.newAnchor),
+		k8s.IdentityIssuerCrtName: []byte(state.issuerCrt),
+		k8s.IdentityIssuerKeyName: []byte(state.issuerKey),
+	}
+}
+
+// podsCreatedBefore lists the meshed pods created before the given time.
+func podsCreatedBefore(ctx context.Context, k kubernetes.Interface, t time.Time) ([]string, error) {
+	pods, err := k.CoreV1().Pods("").List(ctx, metav1.ListOptions{LabelSelector: k8s.ControllerNSLabel})
+	if err != nil {
+		return nil, err
+	}
+	var before []string
+	for _, pod := range pods.Items {
+		if pod.CreationTimestamp.Time.Before(t) {
+			before = append(before, fmt.Sprintf("* %s/%s", pod.Namespace, pod.Name))
+		}
+	}
+	return before, nil
+}
+
+// anchorsInclude returns whether the PEM-encoded trust anchors include the
+// given certificate.
+func anchorsInclude(anchorsPEM string, crt *x509.Certificate) bool {
+	anchors, err := tls.DecodePEMCertificates(anchorsPEM)
+	if err != nil {
+		return false
+	}
+	for _, anchor := range anchors {
+		if anchor.Equal(crt) {
+			return true
+		}
+	}
+	return false
+}
+
+// anchorsOnly returns whether the PEM-encoded trust anchors consist of the
+// given certificate only.
+func anchorsOnly(anchorsPEM string, crt *x509.Certificate) bool {
+	anchors, err := tls.DecodePEMCertificates(anchorsPEM)
+	if err != nil {
+		return false
+	}
+	for _, anchor := range anchors {
+		if !anchor.Equal(crt) {
+			return false
+		}
+	}
+	return len(anchors) > 0
+}
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func meshedPod(name, anchors string, created time.Time) string {
+	return fmt.Sprintf(`---
+apiVersion: v1
+kind: Pod
+metadata:
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+  name: %s
+  namespace: emojivoto
+  creationTimestamp: %s
+spec:
+  containers:
+  - env:
+    - name: LINKERD2_PROXY_IDENTITY_TRUST_ANCHORS
+      value: |
+%s
+    image: ghcr.io/linkerd/proxy:some-version
+    name: linkerd-proxy
+status:
+  phase: Running
+`, name, created.UTC().Format(time.RFC3339), indentLines(strings.TrimSpace(anchors), "        "))
+}
+
+// applyRotation returns a fake cluster with the given manifests applied,
+// carrying over the rotation state of the previous cluster.
+func applyRotation(t *testing.T, previous *k8s.KubernetesAPI, manifests ...string) *k8s.KubernetesAPI {
+	k, err := k8s.NewFakeAPI(splitManifests(strings.Join(manifests, "\n"))...)
+	if err != nil {
+		t.Fatalf("could not initialize fake k8s API: %s", err)
+	}
+	if previous == nil {
+		return k
+	}
+
+	ctx := context.Background()
+	secret, err := previous.CoreV1().Secrets(controlPlaneNamespace).Get(ctx, k8s.IdentityRotationSecretName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error fetching rotation state: %s", err)
+	}
+	secret.ResourceVersion = ""
+	if _, err := k.CoreV1().Secrets(controlPlaneNamespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Unexpected error carrying over rotation state: %s", err)
+	}
+	return k
+}
+
+func configuredAnchors(t *testing.T, k *k8s.KubernetesAPI) string {
+	_, configs, err := healthcheck.FetchLinkerdConfigMap(context.Background(), k, controlPlaneNamespace)
+	if err != nil {
+		t.Fatalf("Unexpected error fetching configs: %s", err)
+	}
+	return configs.GetGlobal().GetIdentityContext().GetTrustAnchorsPem()
+}
+
+func rotationPhaseOf(t *testing.T, k *k8s.KubernetesAPI) rotationPhase {
+	state, err := fetchRotationState(context.Background(), k)
+	if err != nil {
+		t.Fatalf("Unexpected error fetching rotation state: %s", err)
+	}
+	return state.phase
+}
+
+func TestIdentityRotate(t *testing.T) {
+	installOpts, installFlags, _, _ := testOptionsAndFlags(t)
+	installBuf := renderInstall(t, installValues(t, installOpts, installFlags))
+	install := installBuf.String()
+
+	options := newIdentityRotateOptions()
+	options.newUpgradeOptions = testUpgradeOptions
+	ctx := context.Background()
+	longAgo := time.Now().Add(-48 * time.Hour)
+
+	k := applyRotation(t, nil, install)
+	oldAnchors := configuredAnchors(t, k)
+	k = applyRotation(t, nil, install, meshedPod("web", oldAnchors, longAgo))
+
+	// Phase 1: the new trust anchor gets bundled with the old one
+	var out, status bytes.Buffer
+	if err := options.run(ctx, k, &out, &status); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if phase := rotationPhaseOf(t, k); phase != rotationPhaseBundleAnchors {
+		t.Fatalf("Expected phase %s, got %s", rotationPhaseBundleAnchors, phase)
+	}
+	state, err := fetchRotationState(ctx, k)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	bundle := strings.TrimSpace(oldAnchors) + "\n" + strings.TrimSpace(state.newAnchor)
+	bundled := out.String()
+
+	// Proxies that haven't been restarted block the rotation
+	k = applyRotation(t, k, bundled, meshedPod("web", oldAnchors, longAgo))
+	if !strings.Contains(configuredAnchors(t, k), strings.TrimSpace(state.newAnchor)) {
+		t.Fatalf("Expected the new trust anchor to be bundled, got:\n%s", configuredAnchors(t, k))
+	}
+	out.Reset()
+	err = options.run(ctx, k, &out, &status)
+	if err == nil || !strings.Contains(err.Error(), "* emojivoto/web") {
+		t.Fatalf("Expected error listing emojivoto/web, got: %v", err)
+	}
+	if out.Len() != 0 {
+		t.Fatalf("Expected no manifests, got:\n%s", out.String())
+	}
+
+	// Phase 2: once proxies have the bundle, the issuer is swapped
+	k = applyRotation(t, k, bundled, meshedPod("web", bundle, longAgo))
+	out.Reset()
+	if err := options.run(ctx, k, &out, &status); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if phase := rotationPhaseOf(t, k); phase != rotationPhaseSwapIssuer {
+		t.Fatalf("Expected phase %s, got %s", rotationPhaseSwapIssuer, phase)
+	}
+	if !strings.Contains(out.String(), "linkerd-identity-issuer") {
+		t.Fatalf("Expected the issuer secret to be rendered, got:\n%s", out.String())
+	}
+
+	// Pods created before the new issuer was observed must be restarted
+	swapped := out.String()
+	k = applyRotation(t, k, swapped, meshedPod("web", bundle, longAgo))
+	out.Reset()
+	err = options.run(ctx, k, &out, &status)
+	if err == nil || !strings.Contains(err.Error(), "* emojivoto/web") {
+		t.Fatalf("Expected error listing emojivoto/web, got: %v", err)
+	}
+
+	// Phase 3: once restarted, the old trust anchor is removed
+	k = applyRotation(t, k, swapped, meshedPod("web", bundle, time.Now().Add(time.Hour)))
+	out.Reset()
+	if err := options.run(ctx, k, &out, &status); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if phase := rotationPhaseOf(t, k); phase != rotationPhaseRemoveOldAnchors {
+		t.Fatalf("Expected phase %s, got %s", rotationPhaseRemoveOldAnchors, phase)
+	}
+
+	k = applyRotation(t, k, out.String(), meshedPod("web", state.newAnchor, time.Now().Add(time.Hour)))
+	if strings.TrimSpace(configuredAnchors(t, k)) != strings.TrimSpace(state.newAnchor) {
+		t.Fatalf("Expected only the new trust anchor, got:\n%s", configuredAnchors(t, k))
+	}
+	out.Reset()
+	if err := options.run(ctx, k, &out, &status); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, err := fetchRotationState(ctx, k); err == nil {
+		t.Fatal("Expected the rotation state to be removed once complete")
+	}
+}
@@ -125,9 +125,13 @@ var (
 		"templates/controller-rbac.yaml",
 		"templates/destination-rbac.yaml",
 		"templates/heartbeat-rbac.yaml",
+		"templates/health-checker-rbac.yaml",
 		"templates/web-rbac.yaml",
 		"templates/serviceprofile-crd.yaml",
 		"templates/trafficsplit-crd.yaml",
+		"templates/identitydenylist-crd.yaml",
+		"templates/externalworkload-crd.yaml",
+		"templates/serverauthorization-crd.yaml",
 		"templates/proxy-injector-rbac.yaml",
 		"templates/sp-validator-rbac.yaml",
 		"templates/tap-rbac.yaml",
@@ -142,6 +146,7 @@ var (
 		"templates/controller.yaml",
 		"templates/destination.yaml",
 		"templates/heartbeat.yaml",
+		"templates/health-checker.yaml",
 		"templates/web.yaml",
 		"templates/proxy-injector.yaml",
 		"templates/sp-validator.yaml",
@@ -188,33 +193,35 @@ func newInstallOptionsWithDefaults() (*installOptions, error) {
 		restrictDashboardPrivileges: defaults.RestrictDashboardPrivileges,
 		controlPlaneTracing:         defaults.Global.ControlPlaneTracing,
 		proxyConfigOptions: &proxyConfigOptions{
-			proxyVersion:           version.Version,
-			ignoreCluster:          false,
-			proxyImage:             defaults.Global.Proxy.Image.Name,
-			destinationGetNetworks: strings.Split(defaults.Global.Proxy.DestinationGetNetworks, ","),
-			initImage:              defaults.Global.ProxyInit.Image.Name,
-			initImageVersion:       version.ProxyInitVersion,
-			debugImage:             defaults.DebugContainer.Image.Name,
-			debugImageVersion:      version.Version,
-			dockerRegistry:         defaultDockerRegistry,
-			imagePullPolicy:        defaults.Global.ImagePullPolicy,
-			ignoreInboundPorts:     nil,
-			ignoreOutboundPorts:    nil,
-			proxyUID:               defaults.Global.Proxy.UID,
-			proxyLogLevel:          defaults.Global.Proxy.LogLevel,
-			proxyLogFormat:         defaults.Global.Proxy.LogFormat,
-			proxyControlPort:       uint(defaults.Global.Proxy.Ports.Control),
-			proxyAdminPort:         uint(defaults.Global.Proxy.Ports.Admin),
-			proxyInboundPort:       uint(defaults.Global.Proxy.Ports.Inbound),
-			proxyOutboundPort:      uint(defaults.Global.Proxy.Ports.Outbound),
-			proxyCPURequest:        defaults.Global.Proxy.Resources.CPU.Request,
-			proxyMemoryRequest:     defaults.Global.Proxy.Resources.Memory.Request,
-			proxyCPULimit:          defaults.Global.Proxy.Resources.CPU.Limit,
-			proxyMemoryLimit:       defaults.Global.Proxy.Resources.Memory.Limit,
-			enableExternalProfiles: defaults.Global.Proxy.EnableExternalProfiles,
-			waitBeforeExitSeconds:  defaults.Global.Proxy.WaitBeforeExitSeconds,
-			inboundConnectTimeout:  defaults.Global.Proxy.InboundConnectTimeout,
-			outboundConnectTimeout: defaults.Global.Proxy.OutboundConnectTimeout,
+			proxyVersion:                   version.Version,
+			ignoreCluster:                  false,
+			proxyImage:                     defaults.Global.Proxy.Image.Name,
+			destinationGetNetworks:         strings.Split(defaults.Global.Proxy.DestinationGetNetworks, ","),
+			initImage:                      defaults.Global.ProxyInit.Image.Name,
+			initImageVersion:               version.ProxyInitVersion,
+			debugImage:                     defaults.DebugContainer.Image.Name,
+			debugImageVersion:              version.Version,
+			dockerRegistry:                 defaultDockerRegistry,
+			imagePullPolicy:                defaults.Global.ImagePullPolicy,
+			ignoreInboundPorts:             nil,
+			ignoreOutboundPorts:            nil,
+			proxyUID:                       defaults.Global.Proxy.UID,
+			proxyLogLevel:                  defaults.Global.Proxy.LogLevel,
+			proxyLogFormat:                 defaults.Global.Proxy.LogFormat,
+			proxyControlPort:               uint(defaults.Global.Proxy.Ports.Control),
+			proxyAdminPort:                 uint(defaults.Global.Proxy.Ports.Admin),
+			proxyInboundPort:               uint(defaults.Global.Proxy.Ports.Inbound),
+			proxyOutboundPort:              uint(defaults.Global.Proxy.Ports.Outbound),
+			proxyCPURequest:                defaults.Global.Proxy.Resources.CPU.Request,
+			proxyMemoryRequest:             defaults.Global.Proxy.Resources.Memory.Request,
+			proxyCPULimit:                  defaults.Global.Proxy.Resources.CPU.Limit,
+			proxyMemoryLimit:               defaults.Global.Proxy.Resources.Memory.Limit,
+			enableExternalProfiles:         defaults.Global.Proxy.EnableExternalProfiles,
+			waitBeforeExitSeconds:          defaults.Global.Proxy.WaitBeforeExitSeconds,
+			inboundConnectTimeout:          defaults.Global.Proxy.InboundConnectTimeout,
+			outboundConnectTimeout:         defaults.Global.Proxy.OutboundConnectTimeout,
+			identityTokenAudience:          defaults.Global.Proxy.IdentityToken.Audience,
+			identityTokenExpirationSeconds: defaults.Global.Proxy.IdentityToken.ExpirationSeconds,
 		},
 		identityOptions: &installIdentityOptions{
 			trustDomain:            defaults.Global.IdentityTrustDomain,
@@ -427,6 +434,8 @@ func (options *installOptions) validateAndBuildWithIdentity(ctx context.Context,
 	if err != nil {
 		return nil, nil, err
 	}
+	identityValues.Identity.AllowLegacyTokens = values.Identity.AllowLegacyTokens
+	identityValues.Identity.ExternalSigner = values.Identity.ExternalSigner
 	values.Identity = identityValues.Identity
 	values.Global.IdentityTrustAnchorsPEM = identityValues.TrustAnchorsPEM
 	values.Global.IdentityTrustDomain = identityValues.TrustDomain
@@ -864,6 +873,10 @@ func (options *installOptions) buildValuesWithoutIdentity(configs *pb.All) (*l5d
 		},
 		UID:   options.proxyUID,
 		Trace: installValues.Global.Proxy.Trace,
+		IdentityToken: &l5dcharts.IdentityToken{
+			Audience:          options.identityTokenAudience,
+			ExpirationSeconds: options.identityTokenExpirationSeconds,
+		},
 	}
 
 	installValues.Global.ProxyInit.Image.Name = registryOverride(options.initImage, options.dockerRegistry)
@@ -1059,7 +1072,9 @@ func (options *installOptions) proxyConfig() *pb.Proxy {
 			ImageName:  registryOverride(options.debugImage, options.dockerRegistry),
 			PullPolicy: options.imagePullPolicy,
 		},
-		DebugImageVersion: options.debugImageVersion,
+		DebugImageVersion:              options.debugImageVersion,
+		IdentityTokenAudience:          options.identityTokenAudience,
+		IdentityTokenExpirationSeconds: options.identityTokenExpirationSeconds,
 	}
 }
 
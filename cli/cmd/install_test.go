@@ -8,7 +8,11 @@ import (
 	"testing"
 
 	"github.com/linkerd/linkerd2/controller/gen/config"
+	healthchecker "github.com/linkerd/linkerd2/controller/health-checker"
 	charts "github.com/linkerd/linkerd2/pkg/charts/linkerd2"
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -155,6 +159,7 @@ func TestRender(t *testing.T) {
 		ProxyInjector:      defaultValues.ProxyInjector,
 		ProfileValidator:   defaultValues.ProfileValidator,
 		Tap:                defaultValues.Tap,
+		HealthChecker:      defaultValues.HealthChecker,
 		Dashboard: &charts.Dashboard{
 			Replicas: 1,
 		},
@@ -275,6 +280,38 @@ func TestRender(t *testing.T) {
 	withCustomDestinationGetNetsValues, _, _ := withCustomDestinationGetNets.validateAndBuild(ctx, "", nil)
 	addFakeTLSSecrets(withCustomDestinationGetNetsValues)
 
+	withHealthChecker, err := testInstallOptions()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v\n", err)
+	}
+	withHealthCheckerValues, _, _ := withHealthChecker.validateAndBuild(ctx, "", nil)
+	withHealthCheckerValues.HealthChecker.Enabled = true
+	withHealthCheckerValues.HealthChecker.Categories = []string{"linkerd-identity", "linkerd-data-plane"}
+	addFakeTLSSecrets(withHealthCheckerValues)
+
+	withVaultSigner, err := testInstallOptions()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v\n", err)
+	}
+	withVaultSignerValues, _, _ := withVaultSigner.validateAndBuild(ctx, "", nil)
+	withVaultSignerValues.Identity.ExternalSigner.Type = "vault"
+	withVaultSignerValues.Identity.ExternalSigner.Vault = &charts.VaultSigner{
+		Addr:            "https://vault.vault.svc:8200",
+		SignPath:        "pki_int/sign/linkerd-identity",
+		TokenSecretName: "linkerd-identity-vault-token",
+		CASecretName:    "linkerd-identity-vault-ca",
+	}
+	addFakeTLSSecrets(withVaultSignerValues)
+
+	withCertManagerSigner, err := testInstallOptions()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v\n", err)
+	}
+	withCertManagerSignerValues, _, _ := withCertManagerSigner.validateAndBuild(ctx, "", nil)
+	withCertManagerSignerValues.Identity.ExternalSigner.Type = "cert-manager"
+	withCertManagerSignerValues.Identity.ExternalSigner.CertManager.IssuerName = "linkerd-identity"
+	addFakeTLSSecrets(withCertManagerSignerValues)
+
 	testCases := []struct {
 		values         *charts.Values
 		goldenFileName string
@@ -294,6 +331,9 @@ func TestRender(t *testing.T) {
 		{withAddOnConfigStageValues, "install_addon_config.golden"},
 		{withAddOnControlPlaneStageValues, "install_addon_control-plane.golden"},
 		{withCustomDestinationGetNetsValues, "install_default_override_dst_get_nets.golden"},
+		{withHealthCheckerValues, "install_health_checker.golden"},
+		{withVaultSignerValues, "install_external_signer_vault.golden"},
+		{withCertManagerSignerValues, "install_external_signer_cert_manager.golden"},
 	}
 
 	for i, tc := range testCases {
@@ -546,3 +586,128 @@ func addFakeTLSSecrets(values *charts.Values) {
 	values.Tap.KeyPEM = "tap key"
 	values.Tap.CaBundle = "tap CA bundle"
 }
+
+// healthCheckerAPICall is a Kubernetes API call made by a category of checks.
+// Namespaced calls are only made in the control plane namespace, unless
+// allNamespaces is set.
+type healthCheckerAPICall struct {
+	verb          string
+	group         string
+	resource      string
+	namespaced    bool
+	allNamespaces bool
+}
+
+// healthCheckerAPICalls lists the Kubernetes API calls made by the checks of
+// each default category of the health-checker. The checks reaching the public
+// API through -api-addr and the discovery endpoints aren't listed.
+var healthCheckerAPICalls = map[healthcheck.CategoryID][]healthCheckerAPICall{
+	healthcheck.KubernetesAPIChecks: {},
+	healthcheck.LinkerdControlPlaneExistenceChecks: {
+		{"get", "", "configmaps", true, false},
+		{"list", "", "serviceaccounts", true, false},
+		{"list", "apps", "replicasets", true, false},
+		{"list", "", "pods", true, false},
+	},
+	healthcheck.LinkerdConfigChecks: {
+		{"get", "", "namespaces", false, false},
+		{"list", "rbac.authorization.k8s.io", "clusterroles", false, false},
+		{"list", "rbac.authorization.k8s.io", "clusterrolebindings", false, false},
+		{"list", "", "serviceaccounts", true, false},
+		{"list", "apiextensions.k8s.io", "customresourcedefinitions", false, false},
+		{"list", "admissionregistration.k8s.io", "mutatingwebhookconfigurations", false, false},
+		{"list", "admissionregistration.k8s.io", "validatingwebhookconfigurations", false, false},
+		{"list", "policy", "podsecuritypolicies", false, false},
+	},
+	healthcheck.LinkerdIdentity: {
+		{"get", "", "configmaps", true, false},
+		{"get", "", "secrets", true, false},
+		{"list", "identity.linkerd.io", "identitydenylists", false, false},
+	},
+	healthcheck.LinkerdWebhooksAndAPISvcTLS: {
+		{"get", "apiregistration.k8s.io", "apiservices", false, false},
+		{"get", "admissionregistration.k8s.io", "mutatingwebhookconfigurations", false, false},
+		{"get", "admissionregistration.k8s.io", "validatingwebhookconfigurations", false, false},
+		{"get", "", "secrets", true, false},
+	},
+	healthcheck.LinkerdAPIChecks: {
+		{"list", "", "pods", true, false},
+		{"get", "apiregistration.k8s.io", "apiservices", false, false},
+	},
+	healthcheck.LinkerdDataPlaneChecks: {
+		{"get", "", "namespaces", false, false},
+		{"get", "", "configmaps", true, false},
+		{"list", "", "pods", true, true},
+		{"get", "apps", "replicasets", true, true},
+		{"get", "apps", "statefulsets", true, true},
+		{"get", "apps", "daemonsets", true, true},
+		{"get", "batch", "jobs", true, true},
+		{"get", "", "replicationcontrollers", true, true},
+	},
+}
+
+func TestHealthCheckerRBAC(t *testing.T) {
+	options, err := testInstallOptions()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	values, _, err := options.validateAndBuild(context.Background(), "", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error validating options: %v", err)
+	}
+	values.HealthChecker.Enabled = true
+	addFakeTLSSecrets(values)
+	manifests := renderInstall(t, values)
+
+	var clusterRules, namespaceRules []rbacv1.PolicyRule
+	for _, manifest := range splitManifests(manifests.String()) {
+		var role rbacv1.ClusterRole
+		if err := yaml.Unmarshal([]byte(manifest), &role); err != nil {
+			continue
+		}
+		switch {
+		case role.Kind == "ClusterRole" && role.Name == "linkerd-linkerd-health-checker":
+			clusterRules = role.Rules
+		case role.Kind == "Role" && role.Name == "linkerd-health-checker":
+			namespaceRules = role.Rules
+		}
+	}
+	if clusterRules == nil || namespaceRules == nil {
+		t.Fatal("Expected the health-checker ClusterRole and Role to be rendered")
+	}
+
+	allows := func(rules []rbacv1.PolicyRule, call healthCheckerAPICall) bool {
+		for _, rule := range rules {
+			if contains(rule.APIGroups, call.group) && contains(rule.Resources, call.resource) && contains(rule.Verbs, call.verb) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, category := range healthchecker.DefaultCategories {
+		calls, ok := healthCheckerAPICalls[category]
+		if !ok {
+			t.Errorf("The API calls of the %s category aren't listed", category)
+			continue
+		}
+		for _, call := range calls {
+			if allows(clusterRules, call) {
+				continue
+			}
+			if call.namespaced && !call.allNamespaces && allows(namespaceRules, call) {
+				continue
+			}
+			t.Errorf("The health-checker isn't allowed to %s %s in API group %q, as required by the %s category", call.verb, call.resource, call.group, category)
+		}
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// newCmdPolicy creates a new cobra command `policy` which contains
+// subcommands to work with the ServerAuthorizations of the mesh
+func newCmdPolicy() *cobra.Command {
+	policyCmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Work with the authorization policies of the mesh",
+		Args:  cobra.NoArgs,
+	}
+
+	policyCmd.AddCommand(newCmdPolicySimulate())
+
+	return policyCmd
+}
@@ -0,0 +1,390 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/linkerd/linkerd2/controller/api/util"
+	authz "github.com/linkerd/linkerd2/controller/gen/apis/policy/v1alpha1"
+	spclient "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/policy"
+	"github.com/spf13/cobra"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	yamlDecoder "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+type policySimulateOptions struct {
+	filename      string
+	namespace     string
+	allNamespaces bool
+	timeWindow    string
+	outputFormat  string
+}
+
+func newPolicySimulateOptions() *policySimulateOptions {
+	return &policySimulateOptions{
+		namespace:     defaultNamespace,
+		allNamespaces: false,
+		timeWindow:    "1m",
+		outputFormat:  tableOutput,
+	}
+}
+
+func (options *policySimulateOptions) validate() error {
+	if options.filename == "" {
+		return errors.New("a file of ServerAuthorizations is required; use -f")
+	}
+	if options.outputFormat != tableOutput && options.outputFormat != jsonOutput {
+		return fmt.Errorf("--output supports %s and %s", tableOutput, jsonOutput)
+	}
+	return nil
+}
+
+// simulatedEdge is an observed edge and the verdict of the candidate
+// ServerAuthorizations on it
+type simulatedEdge struct {
+	Src          string `json:"src"`
+	SrcNamespace string `json:"src_namespace"`
+	Dst          string `json:"dst"`
+	DstNamespace string `json:"dst_namespace"`
+	Client       string `json:"client_id"`
+	Requests     uint64 `json:"requests"`
+	Authz        string `json:"authorization"`
+}
+
+func newCmdPolicySimulate() *cobra.Command {
+	options := newPolicySimulateOptions()
+
+	cmd := &cobra.Command{
+		Use:   "simulate [flags] (RESOURCETYPE)",
+		Short: "Report the observed edges that candidate ServerAuthorizations would deny",
+		Long: `Report the observed edges that candidate ServerAuthorizations would deny.
+
+  The ServerAuthorizations read from the file are evaluated, along with the
+  ones already installed in the cluster, against the edges observed between
+  resources of RESOURCETYPE (deployments by default) and their client
+  identities. Candidates replace the installed ServerAuthorizations of the
+  same name. Each edge is reported with the number of requests sent over it
+  during the time window, so that the impact of enforcing the candidates can
+  be assessed before applying them.
+
+  Edges don't carry the destination port, so a client is allowed if it's
+  authorized on any port of every destination pod. Edges whose destination
+  pods can't be found are reported as "-".
+
+  Unless --all-namespaces is set, only the edges terminating in the
+  namespaces of the candidates are evaluated.`,
+		Example: `  # Simulate the ServerAuthorizations in policy.yaml against the edges between deployments
+  linkerd policy simulate -f policy.yaml
+
+  # Simulate them against the edges between pods, over the last 10 minutes
+  linkerd policy simulate -f policy.yaml -t 10m po`,
+		Args:      cobra.MaximumNArgs(1),
+		ValidArgs: util.ValidTargets,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(); err != nil {
+				return err
+			}
+
+			resourceType := k8s.Deployment
+			if len(args) == 1 {
+				resourceType = args[0]
+			}
+
+			in, err := read(options.filename)
+			if err != nil {
+				return err
+			}
+			candidates, err := readServerAuthorizations(in, options.namespace)
+			if err != nil {
+				return err
+			}
+
+			client := checkPublicAPIClientOrExit()
+			rows, err := requestSimulatedEdges(client, resourceType, candidates, options)
+			if err != nil {
+				return err
+			}
+
+			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+			if err != nil {
+				return err
+			}
+			authzClient, err := spclient.NewForConfig(k8sAPI.Config)
+			if err != nil {
+				return err
+			}
+			trustDomain, err := fetchTrustDomain(cmd.Context(), k8sAPI)
+			if err != nil {
+				return err
+			}
+			lister := simulatedServerAuthorizations(listServerAuthorizations(authzClient), candidates)
+			authorizations, err := evaluateEdges(cmd.Context(), k8sAPI, lister, trustDomain, rows)
+			if err != nil {
+				return err
+			}
+
+			volumes, err := requestEdgeVolumes(cmd.Context(), client, rows, options.timeWindow)
+			if err != nil {
+				return err
+			}
+
+			return renderSimulatedEdges(simulateEdges(rows, authorizations, volumes), options, os.Stdout)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.filename, "filename", "f", options.filename, "File, directory or URL of the candidate ServerAuthorizations, or \"-\" for stdin")
+	cmd.Flags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the candidate ServerAuthorizations that don't specify one")
+	cmd.Flags().BoolVarP(&options.allNamespaces, "all-namespaces", "A", options.allNamespaces, "If present, evaluates the edges of all namespaces")
+	cmd.Flags().StringVarP(&options.timeWindow, "time-window", "t", options.timeWindow, "Window of the reported request volumes (for example: \"15s\", \"1m\", \"10m\", \"1h\"). Needs to be at least 15s.")
+	cmd.Flags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, fmt.Sprintf("Output format; one of: \"%s\" or \"%s\"", tableOutput, jsonOutput))
+
+	return cmd
+}
+
+// readServerAuthorizations parses and validates the ServerAuthorizations of
+// the YAML documents of the readers. Those without a namespace are placed in
+// defaultNs.
+func readServerAuthorizations(in []io.Reader, defaultNs string) ([]*authz.ServerAuthorization, error) {
+	serverAuthzs := []*authz.ServerAuthorization{}
+	for _, r := range in {
+		reader := yamlDecoder.NewYAMLReader(bufio.NewReaderSize(r, 4096))
+		for {
+			doc, err := reader.Read()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, err
+			}
+
+			var meta metav1.TypeMeta
+			if err := yaml.Unmarshal(doc, &meta); err != nil {
+				return nil, err
+			}
+			if meta.Kind == "" {
+				// empty document
+				continue
+			}
+			if meta.Kind != k8s.ServerAuthorizationKind {
+				return nil, fmt.Errorf("unsupported kind \"%s\"; only %s resources can be simulated", meta.Kind, k8s.ServerAuthorizationKind)
+			}
+
+			var serverAuthz authz.ServerAuthorization
+			if err := yaml.UnmarshalStrict(doc, &serverAuthz); err != nil {
+				return nil, fmt.Errorf("failed to parse ServerAuthorization: %s", err)
+			}
+			if err := policy.ValidateServerAuthorization(&serverAuthz); err != nil {
+				return nil, err
+			}
+			if serverAuthz.Namespace == "" {
+				serverAuthz.Namespace = defaultNs
+			}
+			serverAuthzs = append(serverAuthzs, &serverAuthz)
+		}
+	}
+
+	if len(serverAuthzs) == 0 {
+		return nil, errors.New("no ServerAuthorization found")
+	}
+	return serverAuthzs, nil
+}
+
+// simulatedServerAuthorizations returns a serverAuthorizationsLister listing
+// the ServerAuthorizations of the cluster, as they would be once the candidates
+// are applied
+func simulatedServerAuthorizations(cluster serverAuthorizationsLister, candidates []*authz.ServerAuthorization) serverAuthorizationsLister {
+	return func(ctx context.Context, namespace string) ([]*authz.ServerAuthorization, error) {
+		installed, err := cluster(ctx, namespace)
+		if err != nil {
+			if !kerrors.IsNotFound(err) {
+				return nil, err
+			}
+			// the ServerAuthorization CRD hasn't been installed
+			installed = nil
+		}
+
+		serverAuthzs := []*authz.ServerAuthorization{}
+		candidateNames := map[string]bool{}
+		for _, candidate := range candidates {
+			if candidate.Namespace == namespace {
+				serverAuthzs = append(serverAuthzs, candidate)
+				candidateNames[candidate.Name] = true
+			}
+		}
+		for _, serverAuthz := range installed {
+			if !candidateNames[serverAuthz.Name] {
+				serverAuthzs = append(serverAuthzs, serverAuthz)
+			}
+		}
+		return serverAuthzs, nil
+	}
+}
+
+// requestSimulatedEdges returns the edges terminating in the namespaces of the
+// candidates, or all the edges of the mesh with --all-namespaces
+func requestSimulatedEdges(client pb.ApiClient, resourceType string, candidates []*authz.ServerAuthorization, options *policySimulateOptions) ([]*pb.Edge, error) {
+	namespaces := []string{}
+	if !options.allNamespaces {
+		seen := map[string]bool{}
+		for _, candidate := range candidates {
+			if !seen[candidate.Namespace] {
+				seen[candidate.Namespace] = true
+				namespaces = append(namespaces, candidate.Namespace)
+			}
+		}
+	}
+
+	params := []util.EdgesRequestParams{}
+	if options.allNamespaces {
+		params = append(params, util.EdgesRequestParams{ResourceType: resourceType, AllNamespaces: true})
+	}
+	for _, ns := range namespaces {
+		params = append(params, util.EdgesRequestParams{ResourceType: resourceType, Namespace: ns})
+	}
+
+	rows := []*pb.Edge{}
+	seen := map[string]bool{}
+	for _, p := range params {
+		req, err := util.BuildEdgesRequest(p)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := requestEdgesFromAPI(client, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range edgesRespToRows(resp) {
+			// the edges of a namespace include those originating from it
+			if !options.allNamespaces && r.Dst.Namespace != p.Namespace {
+				continue
+			}
+			key := strings.Join([]string{r.Src.Namespace, r.Src.Name, r.Dst.Namespace, r.Dst.Name, r.ClientId}, "/")
+			if !seen[key] {
+				seen[key] = true
+				rows = append(rows, r)
+			}
+		}
+	}
+	return rows, nil
+}
+
+// requestEdgeVolumes returns the number of requests sent over each edge during
+// the time window, from the outbound response_total metrics of its source
+func requestEdgeVolumes(ctx context.Context, client pb.ApiClient, rows []*pb.Edge, timeWindow string) (map[*pb.Edge]uint64, error) {
+	volumes := map[*pb.Edge]uint64{}
+	for _, r := range rows {
+		req, err := util.BuildStatSummaryRequest(util.StatsSummaryRequestParams{
+			StatsBaseRequestParams: util.StatsBaseRequestParams{
+				TimeWindow:   timeWindow,
+				Namespace:    r.Dst.Namespace,
+				ResourceType: r.Dst.Type,
+				ResourceName: r.Dst.Name,
+			},
+			FromNamespace: r.Src.Namespace,
+			FromType:      r.Src.Type,
+			FromName:      r.Src.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := client.StatSummary(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("StatSummary API error: %s", err)
+		}
+		if e := resp.GetError(); e != nil {
+			return nil, fmt.Errorf("StatSummary API response error: %s", e.Error)
+		}
+
+		for _, table := range resp.GetOk().GetStatTables() {
+			for _, row := range table.GetPodGroup().GetRows() {
+				if row.Stats != nil {
+					volumes[r] += row.Stats.SuccessCount + row.Stats.FailureCount
+				}
+			}
+		}
+	}
+	return volumes, nil
+}
+
+func simulateEdges(rows []*pb.Edge, authorizations edgeAuthorizations, volumes map[*pb.Edge]uint64) []simulatedEdge {
+	edges := []simulatedEdge{}
+	for _, r := range rows {
+		edges = append(edges, simulatedEdge{
+			Src:          r.Src.Name,
+			SrcNamespace: r.Src.Namespace,
+			Dst:          r.Dst.Name,
+			DstNamespace: r.Dst.Namespace,
+			Client:       r.ClientId,
+			Requests:     volumes[r],
+			Authz:        authorizations[r],
+		})
+	}
+
+	// denied edges first, then by SRC/DST namespace and resource
+	sort.Slice(edges, func(i, j int) bool {
+		deniedI := edges[i].Authz == edgeDenied
+		deniedJ := edges[j].Authz == edgeDenied
+		if deniedI != deniedJ {
+			return deniedI
+		}
+		keyI := edges[i].SrcNamespace + edges[i].DstNamespace + edges[i].Src + edges[i].Dst
+		keyJ := edges[j].SrcNamespace + edges[j].DstNamespace + edges[j].Src + edges[j].Dst
+		return keyI < keyJ
+	})
+	return edges
+}
+
+func renderSimulatedEdges(edges []simulatedEdge, options *policySimulateOptions, w io.Writer) error {
+	if options.outputFormat == jsonOutput {
+		b, err := json.MarshalIndent(edges, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", b)
+		return err
+	}
+
+	if len(edges) == 0 {
+		_, err := fmt.Fprintln(w, "No edges found.")
+		return err
+	}
+
+	var denied, deniedRequests uint64
+	tw := tabwriter.NewWriter(w, 0, 0, padding, ' ', 0)
+	fmt.Fprintln(tw, strings.Join([]string{"SRC", "DST", "SRC_NS", "DST_NS", "CLIENT_ID", "REQUESTS", "AUTHZ"}, "\t"))
+	for _, edge := range edges {
+		if edge.Authz == edgeDenied {
+			denied++
+			deniedRequests += edge.Requests
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%d\t%s\n",
+			edge.Src,
+			edge.Dst,
+			edge.SrcNamespace,
+			edge.DstNamespace,
+			orDash(edge.Client),
+			edge.Requests,
+			orDash(edge.Authz),
+		)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d of %d edges would be denied, carrying %d requests in the last %s\n", denied, len(edges), deniedRequests, options.timeWindow)
+	return err
+}
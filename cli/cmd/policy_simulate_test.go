@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/linkerd/linkerd2/controller/api/public"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+)
+
+var policySimulateWebConfigs = []string{`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: emojivoto
+spec:
+  selector:
+    matchLabels:
+      app: web-svc`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-1
+  namespace: emojivoto
+  labels:
+    app: web-svc
+spec:
+  containers:
+  - name: web
+    ports:
+    - name: http
+      containerPort: 8080`,
+}
+
+func TestPolicySimulate(t *testing.T) {
+	for _, tt := range []struct {
+		outputFormat string
+		golden       string
+	}{
+		{tableOutput, "policy_simulate_output.golden"},
+		{jsonOutput, "policy_simulate_output_json.golden"},
+	} {
+		tt := tt // pin
+		t.Run(tt.outputFormat, func(t *testing.T) {
+			options := newPolicySimulateOptions()
+			options.namespace = "emojivoto"
+			options.outputFormat = tt.outputFormat
+
+			f, err := os.Open("testdata/policy_simulate_input.yaml")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			defer f.Close()
+			candidates, err := readServerAuthorizations([]io.Reader{f}, options.namespace)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			mockClient := &public.MockAPIClient{
+				EdgesResponseToReturn:       public.GenEdgesResponse("deployment", "all"),
+				StatSummaryResponseToReturn: public.GenStatSummaryResponse("web", "deployment", []string{"emojivoto"}, nil, true, false),
+			}
+			rows, err := requestSimulatedEdges(mockClient, "deployment", candidates, options)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			k, _, _, authzClient, _, err := k8s.NewFakeClientSets(append(edgesAuthorizationConfigs, policySimulateWebConfigs...)...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			lister := simulatedServerAuthorizations(listServerAuthorizations(authzClient), candidates)
+			authorizations, err := evaluateEdges(context.Background(), k, lister, "cluster.local", rows)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			volumes, err := requestEdgeVolumes(context.Background(), mockClient, rows, options.timeWindow)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var buf bytes.Buffer
+			if err := renderSimulatedEdges(simulateEdges(rows, authorizations, volumes), options, &buf); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			diffTestdata(t, tt.golden, buf.String())
+		})
+	}
+}
+
+func TestReadServerAuthorizations(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		yaml string
+		err  error
+	}{
+		{
+			name: "unsupported kind",
+			yaml: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web`,
+			err: errors.New("unsupported kind \"Pod\"; only ServerAuthorization resources can be simulated"),
+		},
+		{
+			name: "unknown field",
+			yaml: `
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: web
+spec:
+  client:
+    mtls: {}`,
+			err: errors.New("failed to parse ServerAuthorization: error unmarshaling JSON: while decoding JSON: json: unknown field \"mtls\""),
+		},
+		{
+			name: "no ServerAuthorization",
+			yaml: "---\n",
+			err:  errors.New("no ServerAuthorization found"),
+		},
+	} {
+		tt := tt // pin
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := readServerAuthorizations([]io.Reader{strings.NewReader(tt.yaml)}, "default")
+			if err == nil || err.Error() != tt.err.Error() {
+				t.Fatalf("Expected error [%s], got [%v]", tt.err, err)
+			}
+		})
+	}
+}
@@ -29,6 +29,8 @@ const (
 	jsonOutput  = "json"
 	tableOutput = "table"
 	wideOutput  = "wide"
+	junitOutput = "junit"
+	sarifOutput = "sarif"
 
 	maxRps = 100.0
 )
@@ -117,12 +119,14 @@ func init() {
 	RootCmd.AddCommand(newCmdEdges())
 	RootCmd.AddCommand(newCmdEndpoints())
 	RootCmd.AddCommand(newCmdGet())
+	RootCmd.AddCommand(newCmdIdentity())
 	RootCmd.AddCommand(newCmdInject())
 	RootCmd.AddCommand(newCmdInstall())
 	RootCmd.AddCommand(newCmdInstallCNIPlugin())
 	RootCmd.AddCommand(newCmdInstallSP())
 	RootCmd.AddCommand(newCmdLogs())
 	RootCmd.AddCommand(newCmdMetrics())
+	RootCmd.AddCommand(newCmdPolicy())
 	RootCmd.AddCommand(newCmdProfile())
 	RootCmd.AddCommand(newCmdRoutes())
 	RootCmd.AddCommand(newCmdStat())
@@ -138,6 +142,8 @@ func init() {
 type statOptionsBase struct {
 	namespace    string
 	timeWindow   string
+	startTime    string
+	endTime      string
 	outputFormat string
 }
 
@@ -149,6 +155,13 @@ func newStatOptionsBase() *statOptionsBase {
 	}
 }
 
+// addTimeRangeFlags adds the flags querying the metrics of a past time range
+// rather than of the last time window.
+func addTimeRangeFlags(flags *pflag.FlagSet, startTime, endTime *string) {
+	flags.StringVar(endTime, "at", *endTime, "If present, queries the metrics of the time window ending at the given RFC 3339 time (for example: \"2020-10-01T12:00:00Z\") rather than now")
+	flags.StringVar(startTime, "start", *startTime, "If present, queries the metrics from the given RFC 3339 time to the \"--at\" time, or now, ignoring the time window")
+}
+
 func (o *statOptionsBase) validateOutputFormat() error {
 	switch o.outputFormat {
 	case tableOutput, jsonOutput, wideOutput:
@@ -251,6 +264,11 @@ type proxyConfigOptions struct {
 	disableTap                    bool
 	inboundConnectTimeout         string
 	outboundConnectTimeout        string
+	// identityTokenAudience is the audience of the projected service account
+	// token presented by the proxies; if empty, they present the legacy
+	// service account token instead.
+	identityTokenAudience          string
+	identityTokenExpirationSeconds int64
 }
 
 func (options *proxyConfigOptions) validate() error {
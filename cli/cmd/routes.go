@@ -49,12 +49,19 @@ func newCmdRoutes() *cobra.Command {
 		Short: "Display route stats",
 		Long: `Display route stats.
 
-This command will only display traffic which is sent to a service that has a Service Profile defined.`,
+This command will only display traffic which is sent to a service that has a Service Profile defined.
+
+The route stats of a past time range are queried with "--start" and "--at",
+which take RFC 3339 times. "--to" still selects the destination of the
+traffic.`,
 		Example: `  # Routes for the webapp service in the test namespace.
   linkerd routes service/webapp -n test
 
   # Routes for calls from the traffic deployment to the webapp service in the test namespace.
-  linkerd routes deploy/traffic -n test --to svc/webapp`,
+  linkerd routes deploy/traffic -n test --to svc/webapp
+
+  # Routes for the webapp service in the test namespace over the 10 minutes before 12:30 UTC on October 1st 2020.
+  linkerd routes service/webapp -n test -t 10m --at 2020-10-01T12:30:00Z`,
 		Args:      cobra.ExactArgs(1),
 		ValidArgs: util.ValidTargets,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -76,6 +83,7 @@ This command will only display traffic which is sent to a service that has a Ser
 
 	cmd.PersistentFlags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the specified resource")
 	cmd.PersistentFlags().StringVarP(&options.timeWindow, "time-window", "t", options.timeWindow, "Stat window (for example: \"10s\", \"1m\", \"10m\", \"1h\")")
+	addTimeRangeFlags(cmd.PersistentFlags(), &options.startTime, &options.endTime)
 	cmd.PersistentFlags().StringVar(&options.toResource, "to", options.toResource, "If present, shows outbound stats to the specified resource")
 	cmd.PersistentFlags().StringVar(&options.toNamespace, "to-namespace", options.toNamespace, "Sets the namespace used to lookup the \"--to\" resource; by default the current \"--namespace\" is used")
 	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, fmt.Sprintf("Output format; one of: \"%s\", \"%s\", or \"%s\"", tableOutput, wideOutput, jsonOutput))
@@ -323,6 +331,8 @@ func buildTopRoutesRequest(resource string, options *routesOptions) (*pb.TopRout
 	requestParams := util.TopRoutesRequestParams{
 		StatsBaseRequestParams: util.StatsBaseRequestParams{
 			TimeWindow:   options.timeWindow,
+			StartTime:    options.startTime,
+			EndTime:      options.endTime,
 			ResourceName: target.Name,
 			ResourceType: target.Type,
 			Namespace:    options.namespace,
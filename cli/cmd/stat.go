@@ -27,6 +27,7 @@ type statOptions struct {
 	allNamespaces bool
 	labelSelector string
 	unmeshed      bool
+	direct        bool
 }
 
 type indexedResults struct {
@@ -96,7 +97,11 @@ func newCmdStat() *cobra.Command {
   * all (all resource types, not supported in --from or --to)
 
 This command will hide resources that have completed, such as pods that are in the Succeeded or Failed phases.
-If no resource name is specified, displays stats about all resources of the specified RESOURCETYPE`,
+If no resource name is specified, displays stats about all resources of the specified RESOURCETYPE
+
+The stats of a past time range are queried with "--start" and "--at", which
+take RFC 3339 times. "--from" and "--to" still select the source and the
+destination of the traffic.`,
 		Example: `  # Get all deployments in the test namespace.
   linkerd stat deployments -n test
 
@@ -137,7 +142,13 @@ If no resource name is specified, displays stats about all resources of the spec
   linkerd stat namespaces --from ns/default
 
   # Get all inbound stats to the test namespace.
-  linkerd stat ns/test`,
+  linkerd stat ns/test
+
+  # Get all inbound stats to the web deployment between 12:00 and 12:30 UTC on October 1st 2020.
+  linkerd stat deploy/web --start 2020-10-01T12:00:00Z --at 2020-10-01T12:30:00Z
+
+  # Get all inbound stats to the web deployment over 30s, without Prometheus.
+  linkerd stat deploy/web --direct -t 30s`,
 		Args:      cobra.MinimumNArgs(1),
 		ValidArgs: util.ValidTargets,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -187,6 +198,8 @@ If no resource name is specified, displays stats about all resources of the spec
 	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, "Output format; one of: \"table\" or \"json\" or \"wide\"")
 	cmd.PersistentFlags().StringVarP(&options.labelSelector, "selector", "l", options.labelSelector, "Selector (label query) to filter on, supports '=', '==', and '!='")
 	cmd.PersistentFlags().BoolVar(&options.unmeshed, "unmeshed", options.unmeshed, "If present, include unmeshed resources in the output")
+	addTimeRangeFlags(cmd.PersistentFlags(), &options.startTime, &options.endTime)
+	cmd.PersistentFlags().BoolVar(&options.direct, "direct", options.direct, "If present, computes the stats by scraping the proxies of the selected pods over the time window rather than querying Prometheus; the command waits for the whole time window, which must be at most 1m")
 	return cmd
 }
 
@@ -676,6 +689,8 @@ func buildStatSummaryRequests(resources []string, options *statOptions) ([]*pb.S
 		requestParams := util.StatsSummaryRequestParams{
 			StatsBaseRequestParams: util.StatsBaseRequestParams{
 				TimeWindow:    options.timeWindow,
+				StartTime:     options.startTime,
+				EndTime:       options.endTime,
 				ResourceName:  target.Name,
 				ResourceType:  target.Type,
 				Namespace:     options.namespace,
@@ -684,6 +699,7 @@ func buildStatSummaryRequests(resources []string, options *statOptions) ([]*pb.S
 			ToNamespace:   options.toNamespace,
 			FromNamespace: options.fromNamespace,
 			TCPStats:      true,
+			Direct:        options.direct,
 			LabelSelector: options.labelSelector,
 		}
 		if fromRes != nil {
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/linkerd/linkerd2/controller/api/util"
@@ -23,16 +25,18 @@ import (
 type renderTapEventFunc func(*pb.TapEvent, string) string
 
 type tapOptions struct {
-	namespace     string
-	toResource    string
-	toNamespace   string
-	maxRps        float32
-	scheme        string
-	method        string
-	authority     string
-	path          string
-	output        string
-	labelSelector string
+	namespace       string
+	toResource      string
+	toNamespace     string
+	maxRps          float32
+	scheme          string
+	method          string
+	authority       string
+	path            string
+	output          string
+	labelSelector   string
+	summary         bool
+	summaryInterval time.Duration
 }
 
 type endpoint struct {
@@ -103,27 +107,46 @@ type tapEvent struct {
 
 func newTapOptions() *tapOptions {
 	return &tapOptions{
-		namespace:     defaultNamespace,
-		toResource:    "",
-		toNamespace:   "",
-		maxRps:        maxRps,
-		scheme:        "",
-		method:        "",
-		authority:     "",
-		path:          "",
-		output:        "",
-		labelSelector: "",
+		namespace:       defaultNamespace,
+		toResource:      "",
+		toNamespace:     "",
+		maxRps:          maxRps,
+		scheme:          "",
+		method:          "",
+		authority:       "",
+		path:            "",
+		output:          "",
+		labelSelector:   "",
+		summary:         false,
+		summaryInterval: defaultSummaryInterval,
 	}
 }
 
 func (o *tapOptions) validate() error {
-	if o.output == "" || o.output == wideOutput || o.output == jsonOutput {
+	if o.summary {
+		if o.output != "" && o.output != wideOutput {
+			return fmt.Errorf("--summary cannot be combined with output format \"%s\"", o.output)
+		}
+		if o.summaryInterval <= 0 {
+			return fmt.Errorf("--summary-interval must be positive, got %s", o.summaryInterval)
+		}
+		return nil
+	}
+
+	switch o.output {
+	case "", wideOutput, jsonOutput, harOutput, otlpOutput:
 		return nil
 	}
 
 	return fmt.Errorf("output format \"%s\" not recognized", o.output)
 }
 
+// extract returns true if the output format renders request and response
+// headers, which must then be extracted by the proxies.
+func (o *tapOptions) extract() bool {
+	return o.output == jsonOutput || o.output == harOutput || o.output == otlpOutput
+}
+
 func newCmdTap() *cobra.Command {
 	options := newTapOptions()
 
@@ -166,7 +189,16 @@ func newCmdTap() *cobra.Command {
   linkerd tap pod/web-dlbvj
 
   # tap the test namespace, filter by request to prod namespace
-  linkerd tap ns/test --to ns/prod`,
+  linkerd tap ns/test --to ns/prod
+
+  # record requests to the web deployment as an HTTP Archive, until interrupted
+  linkerd tap deploy/web -o har > web.har
+
+  # stream requests to the web deployment as OpenTelemetry spans
+  linkerd tap deploy/web -o otlp-json
+
+  # print one line per request to the web deployment, and a latency histogram every 30s
+  linkerd tap deploy/web --summary --summary-interval 30s`,
 		Args:      cobra.RangeArgs(1, 2),
 		ValidArgs: util.ValidTargets,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -180,7 +212,7 @@ func newCmdTap() *cobra.Command {
 				Method:        options.method,
 				Authority:     options.authority,
 				Path:          options.path,
-				Extract:       options.output == jsonOutput,
+				Extract:       options.extract(),
 				LabelSelector: options.labelSelector,
 			}
 
@@ -220,9 +252,13 @@ func newCmdTap() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&options.path, "path", options.path,
 		"Display requests with paths that start with this prefix")
 	cmd.PersistentFlags().StringVarP(&options.output, "output", "o", options.output,
-		fmt.Sprintf("Output format. One of: \"%s\", \"%s\"", wideOutput, jsonOutput))
+		fmt.Sprintf("Output format. One of: \"%s\", \"%s\", \"%s\", \"%s\"", wideOutput, jsonOutput, harOutput, otlpOutput))
 	cmd.PersistentFlags().StringVarP(&options.labelSelector, "selector", "l", options.labelSelector,
 		"Selector (label query) to filter on, supports '=', '==', and '!='")
+	cmd.PersistentFlags().BoolVar(&options.summary, "summary", options.summary,
+		"Join the request, response and end events of each stream into a single line, and periodically print a latency histogram and status breakdown")
+	cmd.PersistentFlags().DurationVar(&options.summaryInterval, "summary-interval", options.summaryInterval,
+		"Interval at which the latency histogram and status breakdown are printed in --summary mode")
 
 	return cmd
 }
@@ -234,11 +270,31 @@ func requestTapByResourceFromAPI(w io.Writer, k8sAPI *k8s.KubernetesAPI, req *pb
 	}
 	defer body.Close()
 
+	if options.output == harOutput {
+		// The HAR document is only written once the stream ends, so end the
+		// stream on interrupt instead of exiting.
+		stop := make(chan os.Signal, 1)
+		signal.Notify(stop, os.Interrupt)
+		defer signal.Stop(stop)
+		go func() {
+			<-stop
+			body.Close()
+		}()
+	}
+
 	return writeTapEventsToBuffer(w, reader, req, options)
 }
 
 func writeTapEventsToBuffer(w io.Writer, tapByteStream *bufio.Reader, req *pb.TapByResourceRequest, options *tapOptions) error {
 	var err error
+	if options.summary {
+		resource := ""
+		if options.output == wideOutput {
+			resource = req.GetTarget().GetResource().GetType()
+		}
+		return renderTapEventsSummary(tapByteStream, w, resource, options.summaryInterval)
+	}
+
 	switch options.output {
 	case "":
 		err = renderTapEvents(tapByteStream, w, renderTapEvent, "")
@@ -247,6 +303,10 @@ func writeTapEventsToBuffer(w io.Writer, tapByteStream *bufio.Reader, req *pb.Ta
 		err = renderTapEvents(tapByteStream, w, renderTapEvent, resource)
 	case jsonOutput:
 		err = renderTapEvents(tapByteStream, w, renderTapEventJSON, "")
+	case harOutput:
+		err = renderTapEventsHAR(tapByteStream, w)
+	case otlpOutput:
+		err = renderTapEventsOTLP(tapByteStream, w)
 	}
 	if err != nil {
 		return err
@@ -278,39 +338,7 @@ func renderTapEvents(tapByteStream *bufio.Reader, w io.Writer, render renderTapE
 
 // renderTapEvent renders a Public API TapEvent to a string.
 func renderTapEvent(event *pb.TapEvent, resource string) string {
-	dst := dst(event)
-	src := src(event)
-
-	proxy := "???"
-	tls := ""
-	switch event.GetProxyDirection() {
-	case pb.TapEvent_INBOUND:
-		proxy = "in " // A space is added so it aligns with `out`.
-		tls = src.tlsStatus()
-	case pb.TapEvent_OUTBOUND:
-		proxy = "out"
-		tls = dst.tlsStatus()
-	default:
-		// Too old for TLS.
-	}
-
-	flow := fmt.Sprintf("proxy=%s %s %s tls=%s",
-		proxy,
-		src.formatAddr(),
-		dst.formatAddr(),
-		tls,
-	)
-
-	// If `resource` is non-empty, then
-	resources := ""
-	if resource != "" {
-		resources = fmt.Sprintf(
-			"%s%s%s",
-			src.formatResource(resource),
-			dst.formatResource(resource),
-			routeLabels(event),
-		)
-	}
+	flow, resources := formatFlow(event, resource)
 
 	switch ev := event.GetHttp().GetEvent().(type) {
 	case *pb.TapEvent_Http_RequestInit_:
@@ -376,6 +404,46 @@ func renderTapEvent(event *pb.TapEvent, resource string) string {
 	}
 }
 
+// formatFlow formats the proxy direction, peer addresses and TLS status of
+// a tap event and, if `resource` is non-empty, the resources of its peers.
+func formatFlow(event *pb.TapEvent, resource string) (string, string) {
+	dst := dst(event)
+	src := src(event)
+
+	proxy := "???"
+	tls := ""
+	switch event.GetProxyDirection() {
+	case pb.TapEvent_INBOUND:
+		proxy = "in " // A space is added so it aligns with `out`.
+		tls = src.tlsStatus()
+	case pb.TapEvent_OUTBOUND:
+		proxy = "out"
+		tls = dst.tlsStatus()
+	default:
+		// Too old for TLS.
+	}
+
+	flow := fmt.Sprintf("proxy=%s %s %s tls=%s",
+		proxy,
+		src.formatAddr(),
+		dst.formatAddr(),
+		tls,
+	)
+
+	// If `resource` is non-empty, then
+	resources := ""
+	if resource != "" {
+		resources = fmt.Sprintf(
+			"%s%s%s",
+			src.formatResource(resource),
+			dst.formatResource(resource),
+			routeLabels(event),
+		)
+	}
+
+	return flow, resources
+}
+
 // renderTapEventJSON renders a Public API TapEvent to a string in JSON format.
 func renderTapEventJSON(event *pb.TapEvent, _ string) string {
 	m := mapPublicToDisplayTapEvent(event)
@@ -586,3 +654,128 @@ func routeLabels(event *pb.TapEvent) string {
 
 	return out
 }
+
+// tapClock returns the time at which a tap event was observed. Tap events
+// only carry durations relative to the request, so exchanges are anchored
+// on the time their request was received by the CLI.
+var tapClock = time.Now
+
+// tapExchange holds the request, response and end events of a single HTTP
+// stream, as correlated by tapCorrelator.
+type tapExchange struct {
+	requestInit  *pb.TapEvent_Http_RequestInit
+	responseInit *pb.TapEvent_Http_ResponseInit
+	responseEnd  *pb.TapEvent_Http_ResponseEnd
+	// event is the first event seen for this stream. It holds the peer
+	// addresses and metadata shared by the whole exchange.
+	event     *pb.TapEvent
+	startTime time.Time
+}
+
+const (
+	// tapExchangeTimeout is how long an exchange is kept waiting for its end
+	// event, e.g. for streams whose end event was dropped by the tap.
+	tapExchangeTimeout = 5 * time.Minute
+	// maxTapExchanges caps the number of exchanges in flight, the oldest
+	// being evicted first.
+	maxTapExchanges = 10000
+)
+
+// tapCorrelator joins the `req`, `rsp` and `end` events of a stream into a
+// single tapExchange.
+type tapCorrelator struct {
+	exchanges map[string]*tapExchange
+	lastEvict time.Time
+}
+
+func newTapCorrelator() *tapCorrelator {
+	return &tapCorrelator{exchanges: make(map[string]*tapExchange), lastEvict: tapClock()}
+}
+
+// evict forgets the exchanges that have been in flight for longer than
+// tapExchangeTimeout and, if there are still too many of them, the oldest
+// ones.
+func (c *tapCorrelator) evict(now time.Time) {
+	if now.Sub(c.lastEvict) >= tapExchangeTimeout/10 {
+		c.lastEvict = now
+		for key, exchange := range c.exchanges {
+			if now.Sub(exchange.startTime) > tapExchangeTimeout {
+				delete(c.exchanges, key)
+			}
+		}
+	}
+	for len(c.exchanges) >= maxTapExchanges {
+		oldestKey := ""
+		var oldest time.Time
+		for key, exchange := range c.exchanges {
+			if oldestKey == "" || exchange.startTime.Before(oldest) {
+				oldestKey, oldest = key, exchange.startTime
+			}
+		}
+		delete(c.exchanges, oldestKey)
+	}
+}
+
+// add records a tap event, returning the exchange it belongs to once the
+// stream's end event has been seen, or nil while the stream is in flight.
+func (c *tapCorrelator) add(event *pb.TapEvent) *tapExchange {
+	http := event.GetHttp()
+	var id *pb.TapEvent_Http_StreamId
+	switch ev := http.GetEvent().(type) {
+	case *pb.TapEvent_Http_RequestInit_:
+		id = ev.RequestInit.GetId()
+	case *pb.TapEvent_Http_ResponseInit_:
+		id = ev.ResponseInit.GetId()
+	case *pb.TapEvent_Http_ResponseEnd_:
+		id = ev.ResponseEnd.GetId()
+	default:
+		return nil
+	}
+
+	key := fmt.Sprintf("%s %s %s %d:%d",
+		event.GetProxyDirection(),
+		addr.PublicAddressToString(event.GetSource()),
+		addr.PublicAddressToString(event.GetDestination()),
+		id.GetBase(),
+		id.GetStream(),
+	)
+	exchange, ok := c.exchanges[key]
+	if !ok {
+		now := tapClock()
+		c.evict(now)
+		exchange = &tapExchange{event: event, startTime: now}
+		c.exchanges[key] = exchange
+	}
+
+	switch ev := http.GetEvent().(type) {
+	case *pb.TapEvent_Http_RequestInit_:
+		exchange.requestInit = ev.RequestInit
+		// the request carries the richest metadata, prefer it
+		exchange.event = event
+	case *pb.TapEvent_Http_ResponseInit_:
+		exchange.responseInit = ev.ResponseInit
+	case *pb.TapEvent_Http_ResponseEnd_:
+		exchange.responseEnd = ev.ResponseEnd
+		delete(c.exchanges, key)
+		return exchange
+	}
+	return nil
+}
+
+// latency returns the time from the start of the request to the end of the
+// response stream.
+func (e *tapExchange) latency() time.Duration {
+	if d := e.responseEnd.GetSinceRequestInit(); d != nil {
+		return time.Duration(d.GetSeconds())*time.Second + time.Duration(d.GetNanos())
+	}
+	return 0
+}
+
+// timeToFirstByte returns the time from the start of the request to the
+// response headers.
+func (e *tapExchange) timeToFirstByte() time.Duration {
+	if d := e.responseInit.GetSinceRequestInit(); d != nil {
+		return time.Duration(d.GetSeconds())*time.Second + time.Duration(d.GetNanos())
+	}
+	return 0
+}
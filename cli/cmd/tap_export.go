@@ -0,0 +1,507 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/addr"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/protohttp"
+	"github.com/linkerd/linkerd2/pkg/version"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	harOutput  = "har"
+	otlpOutput = "otlp-json"
+)
+
+// readTapExchanges reads tap events until the stream ends, calling onExchange
+// for every exchange whose end event has been seen.
+func readTapExchanges(tapByteStream *bufio.Reader, onExchange func(*tapExchange) error) error {
+	correlator := newTapCorrelator()
+	for {
+		log.Debug("Waiting for data...")
+		event := pb.TapEvent{}
+		err := protohttp.FromByteStreamToProtocolBuffers(tapByteStream, &event)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			break
+		}
+		if exchange := correlator.add(&event); exchange != nil {
+			if err := onExchange(exchange); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+//
+// HTTP Archive (HAR) 1.2, see http://www.softwareishard.com/blog/har-12-spec/
+//
+
+type harLog struct {
+	Log harLogBody `json:"log"`
+}
+
+type harLogBody struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	ServerIPAddress string      `json:"serverIPAddress,omitempty"`
+	Comment         string      `json:"comment,omitempty"`
+}
+
+type harRequest struct {
+	Method      string   `json:"method"`
+	URL         string   `json:"url"`
+	HTTPVersion string   `json:"httpVersion"`
+	Cookies     []harNVP `json:"cookies"`
+	Headers     []harNVP `json:"headers"`
+	QueryString []harNVP `json:"queryString"`
+	HeadersSize int      `json:"headersSize"`
+	BodySize    int      `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      uint32     `json:"status"`
+	StatusText  string     `json:"statusText"`
+	HTTPVersion string     `json:"httpVersion"`
+	Cookies     []harNVP   `json:"cookies"`
+	Headers     []harNVP   `json:"headers"`
+	Content     harContent `json:"content"`
+	RedirectURL string     `json:"redirectURL"`
+	HeadersSize int        `json:"headersSize"`
+	BodySize    int64      `json:"bodySize"`
+}
+
+type harContent struct {
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+}
+
+type harNVP struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// renderTapEventsHAR collects every exchange seen on the tap stream and,
+// once the stream ends, writes them out as a single HAR document.
+func renderTapEventsHAR(tapByteStream *bufio.Reader, w io.Writer) error {
+	har := harLog{
+		Log: harLogBody{
+			Version: "1.2",
+			Creator: harCreator{Name: "linkerd", Version: version.Version},
+			Entries: []harEntry{},
+		},
+	}
+
+	err := readTapExchanges(tapByteStream, func(e *tapExchange) error {
+		har.Log.Entries = append(har.Log.Entries, mapExchangeToHAREntry(e))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	out, err := json.MarshalIndent(har, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
+
+func mapExchangeToHAREntry(e *tapExchange) harEntry {
+	req := e.requestInit
+	rsp := e.responseInit
+	end := e.responseEnd
+
+	wait := millis(e.timeToFirstByte())
+	total := millis(e.latency())
+
+	status := rsp.GetHttpStatus()
+	contentType := ""
+	for _, h := range harHeaders(rsp.GetHeaders()) {
+		if strings.EqualFold(h.Name, "content-type") {
+			contentType = h.Value
+		}
+	}
+
+	entry := harEntry{
+		StartedDateTime: e.startTime.UTC().Format(time.RFC3339Nano),
+		Time:            total,
+		Request: harRequest{
+			Method:      formatMethod(req.GetMethod()),
+			URL:         exchangeURL(req),
+			Cookies:     []harNVP{},
+			Headers:     harHeaders(req.GetHeaders()),
+			QueryString: harQueryString(req.GetPath()),
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Response: harResponse{
+			Status:     status,
+			StatusText: http.StatusText(int(status)),
+			Cookies:    []harNVP{},
+			Headers:    harHeaders(rsp.GetHeaders()),
+			Content: harContent{
+				Size:     int64(end.GetResponseBytes()),
+				MimeType: contentType,
+			},
+			HeadersSize: -1,
+			BodySize:    int64(end.GetResponseBytes()),
+		},
+		Timings: harTimings{
+			Send:    0,
+			Wait:    wait,
+			Receive: total - wait,
+		},
+		ServerIPAddress: addr.PublicIPToString(e.event.GetDestination().GetIp()),
+	}
+
+	switch eos := end.GetEos().GetEnd().(type) {
+	case *pb.Eos_GrpcStatusCode:
+		entry.Comment = fmt.Sprintf("grpc-status=%s", codes.Code(eos.GrpcStatusCode))
+	case *pb.Eos_ResetErrorCode:
+		entry.Comment = fmt.Sprintf("reset-error=%d", eos.ResetErrorCode)
+	}
+
+	return entry
+}
+
+func harHeaders(hs *pb.Headers) []harNVP {
+	headers := []harNVP{}
+	for _, h := range hs.GetHeaders() {
+		value := h.GetValueStr()
+		if bin := h.GetValueBin(); bin != nil {
+			value = string(bin)
+		}
+		headers = append(headers, harNVP{Name: h.GetName(), Value: value})
+	}
+	return headers
+}
+
+func harQueryString(path string) []harNVP {
+	qs := []harNVP{}
+	i := strings.Index(path, "?")
+	if i < 0 {
+		return qs
+	}
+	for _, kv := range strings.Split(path[i+1:], "&") {
+		if kv == "" {
+			continue
+		}
+		parts := strings.SplitN(kv, "=", 2)
+		nvp := harNVP{Name: parts[0]}
+		if len(parts) == 2 {
+			nvp.Value = parts[1]
+		}
+		qs = append(qs, nvp)
+	}
+	return qs
+}
+
+func exchangeURL(req *pb.TapEvent_Http_RequestInit) string {
+	scheme := strings.ToLower(formatScheme(req.GetScheme()))
+	if scheme == "" {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s%s", scheme, req.GetAuthority(), req.GetPath())
+}
+
+func millis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+//
+// OpenTelemetry, see https://github.com/open-telemetry/opentelemetry-proto
+// for the JSON encoding of ExportTraceServiceRequest.
+//
+
+const (
+	otlpSpanKindServer = 2
+	otlpSpanKindClient = 3
+
+	otlpStatusCodeUnset = 0
+	otlpStatusCodeError = 2
+)
+
+type otlpTraces struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+}
+
+func otlpString(key, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpValue{StringValue: &value}}
+}
+
+// OTLP/JSON encodes 64 bit integers as decimal strings.
+func otlpInt(key string, value int64) otlpKeyValue {
+	v := fmt.Sprintf("%d", value)
+	return otlpKeyValue{Key: key, Value: otlpValue{IntValue: &v}}
+}
+
+// renderTapEventsOTLP writes one OTLP/JSON ExportTraceServiceRequest per
+// line, each holding the span for a single exchange.
+func renderTapEventsOTLP(tapByteStream *bufio.Reader, w io.Writer) error {
+	return readTapExchanges(tapByteStream, func(e *tapExchange) error {
+		out, err := json.Marshal(mapExchangeToOTLP(e))
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(out))
+		return err
+	})
+}
+
+func mapExchangeToOTLP(e *tapExchange) otlpTraces {
+	req := e.requestInit
+	end := e.responseEnd
+
+	// The tapped proxy is the client for outbound traffic and the server
+	// for inbound traffic; its workload is the span's resource.
+	kind := otlpSpanKindClient
+	local := e.event.GetSourceMeta().GetLabels()
+	if e.event.GetProxyDirection() == pb.TapEvent_INBOUND {
+		kind = otlpSpanKindServer
+		local = e.event.GetDestinationMeta().GetLabels()
+	}
+
+	status := e.responseInit.GetHttpStatus()
+	attrs := []otlpKeyValue{
+		otlpString("http.method", formatMethod(req.GetMethod())),
+		otlpString("http.scheme", strings.ToLower(formatScheme(req.GetScheme()))),
+		otlpString("http.host", req.GetAuthority()),
+		otlpString("http.target", req.GetPath()),
+		otlpString("http.url", exchangeURL(req)),
+		otlpInt("http.status_code", int64(status)),
+		otlpInt("http.response_content_length", int64(end.GetResponseBytes())),
+		otlpString("net.peer.ip", addr.PublicIPToString(e.event.GetSource().GetIp())),
+		otlpString("net.host.ip", addr.PublicIPToString(e.event.GetDestination().GetIp())),
+		otlpInt("net.host.port", int64(e.event.GetDestination().GetPort())),
+		otlpString("linkerd.proxy_direction", e.event.GetProxyDirection().String()),
+	}
+	attrs = append(attrs, otlpLabelAttributes("linkerd.source.", e.event.GetSourceMeta().GetLabels())...)
+	attrs = append(attrs, otlpLabelAttributes("linkerd.destination.", e.event.GetDestinationMeta().GetLabels())...)
+	attrs = append(attrs, otlpLabelAttributes("linkerd.route.", e.event.GetRouteMeta().GetLabels())...)
+
+	spanStatus := otlpStatus{Code: otlpStatusCodeUnset}
+	if status >= http.StatusInternalServerError {
+		spanStatus = otlpStatus{Code: otlpStatusCodeError, Message: http.StatusText(int(status))}
+	}
+	switch eos := end.GetEos().GetEnd().(type) {
+	case *pb.Eos_GrpcStatusCode:
+		attrs = append(attrs, otlpInt("rpc.grpc.status_code", int64(eos.GrpcStatusCode)))
+		if codes.Code(eos.GrpcStatusCode) != codes.OK {
+			spanStatus = otlpStatus{Code: otlpStatusCodeError, Message: codes.Code(eos.GrpcStatusCode).String()}
+		}
+	case *pb.Eos_ResetErrorCode:
+		spanStatus = otlpStatus{Code: otlpStatusCodeError, Message: fmt.Sprintf("reset-error=%d", eos.ResetErrorCode)}
+	}
+
+	traceID, parentID := traceContext(req.GetHeaders())
+	if traceID == "" {
+		traceID = hashHex(16, "trace "+exchangeKey(e))
+	}
+
+	span := otlpSpan{
+		TraceID:           traceID,
+		SpanID:            hashHex(8, "span "+exchangeKey(e)),
+		ParentSpanID:      parentID,
+		Name:              fmt.Sprintf("%s %s", formatMethod(req.GetMethod()), pathWithoutQuery(req.GetPath())),
+		Kind:              kind,
+		StartTimeUnixNano: fmt.Sprintf("%d", e.startTime.UnixNano()),
+		EndTimeUnixNano:   fmt.Sprintf("%d", e.startTime.Add(e.latency()).UnixNano()),
+		Attributes:        attrs,
+		Status:            spanStatus,
+	}
+
+	return otlpTraces{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{Attributes: otlpResourceAttributes(local)},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: "linkerd-tap", Version: version.Version},
+						Spans: []otlpSpan{span},
+					},
+				},
+			},
+		},
+	}
+}
+
+// otlpResourceAttributes maps the tapped workload's labels to OpenTelemetry
+// Kubernetes resource semantic conventions.
+func otlpResourceAttributes(labels map[string]string) []otlpKeyValue {
+	attrs := []otlpKeyValue{}
+	serviceName := ""
+	for _, kind := range []string{
+		k8s.Deployment, k8s.StatefulSet, k8s.DaemonSet, k8s.ReplicaSet,
+		k8s.ReplicationController, k8s.Job, k8s.CronJob, k8s.Pod,
+	} {
+		if name, ok := labels[kind]; ok {
+			if serviceName == "" {
+				serviceName = name
+			}
+			attrs = append(attrs, otlpString(fmt.Sprintf("k8s.%s.name", kind), name))
+		}
+	}
+	if ns, ok := labels[k8s.Namespace]; ok {
+		attrs = append(attrs, otlpString("k8s.namespace.name", ns))
+	}
+	if serviceName == "" {
+		serviceName = "unknown"
+	}
+	return append([]otlpKeyValue{otlpString("service.name", serviceName)}, attrs...)
+}
+
+func otlpLabelAttributes(prefix string, labels map[string]string) []otlpKeyValue {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := []otlpKeyValue{}
+	for _, k := range keys {
+		attrs = append(attrs, otlpString(prefix+k, labels[k]))
+	}
+	return attrs
+}
+
+// traceContext extracts the trace and parent span IDs propagated on a
+// request, from either W3C `traceparent` or B3 headers.
+func traceContext(hs *pb.Headers) (string, string) {
+	var traceID, spanID string
+	for _, h := range hs.GetHeaders() {
+		switch strings.ToLower(h.GetName()) {
+		case "traceparent":
+			parts := strings.Split(h.GetValueStr(), "-")
+			if len(parts) == 4 && len(parts[1]) == 32 && len(parts[2]) == 16 {
+				return parts[1], parts[2]
+			}
+		case "x-b3-traceid":
+			traceID = h.GetValueStr()
+			// 64 bit B3 trace IDs are left-padded to 128 bits
+			if len(traceID) == 16 {
+				traceID = strings.Repeat("0", 16) + traceID
+			}
+		case "x-b3-spanid":
+			spanID = h.GetValueStr()
+		}
+	}
+	return traceID, spanID
+}
+
+func exchangeKey(e *tapExchange) string {
+	return fmt.Sprintf("%s %s %s %d:%d %d",
+		e.event.GetProxyDirection(),
+		addr.PublicAddressToString(e.event.GetSource()),
+		addr.PublicAddressToString(e.event.GetDestination()),
+		e.requestInit.GetId().GetBase(),
+		e.requestInit.GetId().GetStream(),
+		e.startTime.UnixNano(),
+	)
+}
+
+// hashHex derives a stable, non-zero hex-encoded ID of n bytes from s.
+func hashHex(n int, s string) string {
+	b := make([]byte, 0, n)
+	for i := 0; len(b) < n; i++ {
+		h := fnv.New64a()
+		fmt.Fprintf(h, "%d %s", i, s)
+		b = append(b, make([]byte, 8)...)
+		binary.BigEndian.PutUint64(b[len(b)-8:], h.Sum64())
+	}
+	return hex.EncodeToString(b[:n])
+}
+
+func pathWithoutQuery(path string) string {
+	if i := strings.Index(path, "?"); i >= 0 {
+		return path[:i]
+	}
+	return path
+}
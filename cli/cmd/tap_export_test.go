@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+)
+
+func TestTraceContext(t *testing.T) {
+	header := func(name, value string) *pb.Headers_Header {
+		return &pb.Headers_Header{Name: name, Value: &pb.Headers_Header_ValueStr{ValueStr: value}}
+	}
+
+	testCases := []struct {
+		headers  []*pb.Headers_Header
+		traceID  string
+		parentID string
+	}{
+		{
+			headers: []*pb.Headers_Header{
+				header("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"),
+			},
+			traceID:  "0af7651916cd43dd8448eb211c80319c",
+			parentID: "b7ad6b7169203331",
+		},
+		{
+			headers: []*pb.Headers_Header{
+				header("X-B3-TraceId", "463ac35c9f6413ad"),
+				header("X-B3-SpanId", "a2fb4a1d1a96d312"),
+			},
+			traceID:  "0000000000000000463ac35c9f6413ad",
+			parentID: "a2fb4a1d1a96d312",
+		},
+		{
+			headers: []*pb.Headers_Header{
+				header("traceparent", "garbage"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		traceID, parentID := traceContext(&pb.Headers{Headers: tc.headers})
+		if traceID != tc.traceID || parentID != tc.parentID {
+			t.Errorf("Expected trace context (%q, %q), got (%q, %q)", tc.traceID, tc.parentID, traceID, parentID)
+		}
+	}
+}
+
+func TestHarQueryString(t *testing.T) {
+	expected := []harNVP{{Name: "a", Value: "1"}, {Name: "b", Value: ""}}
+	qs := harQueryString("/books?a=1&b")
+	if !reflect.DeepEqual(qs, expected) {
+		t.Errorf("Expected query string %+v, got %+v", expected, qs)
+	}
+
+	if qs := harQueryString("/books"); len(qs) != 0 {
+		t.Errorf("Expected empty query string, got %+v", qs)
+	}
+}
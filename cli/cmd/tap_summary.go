@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/protohttp"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	defaultSummaryInterval = 10 * time.Second
+	histogramWidth         = 40
+)
+
+// latencyBuckets are the upper bounds of the histogram printed in summary
+// mode. Latencies above the last bound fall into an overflow bucket.
+var latencyBuckets = []time.Duration{
+	1 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	1 * time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// tapSummary accumulates the latencies and statuses of the exchanges seen
+// during a summary interval.
+type tapSummary struct {
+	buckets  []uint64
+	statuses map[string]uint64
+	count    uint64
+}
+
+func newTapSummary() *tapSummary {
+	return &tapSummary{
+		buckets:  make([]uint64, len(latencyBuckets)+1),
+		statuses: make(map[string]uint64),
+	}
+}
+
+func (s *tapSummary) observe(e *tapExchange) {
+	s.count++
+	s.statuses[exchangeStatus(e)]++
+
+	latency := e.latency()
+	i := sort.Search(len(latencyBuckets), func(i int) bool { return latency <= latencyBuckets[i] })
+	s.buckets[i]++
+}
+
+// render writes the ASCII latency histogram and the status code breakdown
+// for the exchanges observed over the given period.
+func (s *tapSummary) render(w io.Writer, period time.Duration) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n--- %d requests in the last %s ---\n", s.count, period)
+	if s.count == 0 {
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+
+	var max uint64
+	for _, c := range s.buckets {
+		if c > max {
+			max = c
+		}
+	}
+
+	b.WriteString("latency:\n")
+	for i, c := range s.buckets {
+		label := fmt.Sprintf("> %s", latencyBuckets[len(latencyBuckets)-1])
+		if i < len(latencyBuckets) {
+			label = fmt.Sprintf("<= %s", latencyBuckets[i])
+		}
+		bar := int(c * histogramWidth / max)
+		if c > 0 && bar == 0 {
+			bar = 1
+		}
+		fmt.Fprintf(&b, "  %9s |%-*s| %d\n", label, histogramWidth, strings.Repeat("#", bar), c)
+	}
+
+	statuses := make([]string, 0, len(s.statuses))
+	for status := range s.statuses {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	b.WriteString("status:\n")
+	for _, status := range statuses {
+		c := s.statuses[status]
+		fmt.Fprintf(&b, "  %-24s %6d (%.1f%%)\n", status, c, float64(c)*100/float64(s.count))
+	}
+	b.WriteString("\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// exchangeStatus describes how an exchange ended: its gRPC status if it had
+// one, a stream reset, or its HTTP status otherwise.
+func exchangeStatus(e *tapExchange) string {
+	switch eos := e.responseEnd.GetEos().GetEnd().(type) {
+	case *pb.Eos_GrpcStatusCode:
+		return fmt.Sprintf("grpc-status=%s", codes.Code(eos.GrpcStatusCode))
+	case *pb.Eos_ResetErrorCode:
+		return fmt.Sprintf("reset-error=%d", eos.ResetErrorCode)
+	}
+	if e.responseInit == nil {
+		return ":status=none"
+	}
+	return fmt.Sprintf(":status=%d", e.responseInit.GetHttpStatus())
+}
+
+// renderExchange renders a completed exchange as a single line.
+func renderExchange(e *tapExchange, resource string) string {
+	flow, resources := formatFlow(e.event, resource)
+	return fmt.Sprintf("%s %s %s latency=%dµs response-length=%dB %s%s",
+		formatMethod(e.requestInit.GetMethod()),
+		exchangeURL(e.requestInit),
+		exchangeStatus(e),
+		e.latency().Microseconds(),
+		e.responseEnd.GetResponseBytes(),
+		flow,
+		resources,
+	)
+}
+
+// renderTapEventsSummary prints one line per completed exchange, and every
+// interval a histogram of the latencies and statuses seen since the last one.
+func renderTapEventsSummary(tapByteStream *bufio.Reader, w io.Writer, resource string, interval time.Duration) error {
+	events := make(chan *pb.TapEvent)
+	// done stops the reader once this function returns, e.g. on a write error.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(events)
+		for {
+			log.Debug("Waiting for data...")
+			event := pb.TapEvent{}
+			err := protohttp.FromByteStreamToProtocolBuffers(tapByteStream, &event)
+			if err != nil {
+				if err != io.EOF {
+					fmt.Fprintln(os.Stderr, err)
+				}
+				return
+			}
+			select {
+			case events <- &event:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	correlator := newTapCorrelator()
+	summary := newTapSummary()
+	since := tapClock()
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return summary.render(w, tapClock().Sub(since).Round(time.Second))
+			}
+			exchange := correlator.add(event)
+			if exchange == nil {
+				continue
+			}
+			summary.observe(exchange)
+			if _, err := fmt.Fprintln(w, renderExchange(exchange, resource)); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := summary.render(w, interval); err != nil {
+				return err
+			}
+			summary = newTapSummary()
+			since = tapClock()
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/linkerd/linkerd2/controller/api/util"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/protohttp"
+	"google.golang.org/grpc/codes"
+)
+
+func summaryEvents(stream uint64, status uint32, latency time.Duration, eos *pb.Eos) []*pb.TapEvent {
+	id := &pb.TapEvent_Http_StreamId{Base: 1, Stream: stream}
+	meta := map[string]string{"pod": "my-pod", "tls": "true"}
+	return []*pb.TapEvent{
+		util.CreateTapEvent(&pb.TapEvent_Http{
+			Event: &pb.TapEvent_Http_RequestInit_{
+				RequestInit: &pb.TapEvent_Http_RequestInit{
+					Id: id,
+					Method: &pb.HttpMethod{
+						Type: &pb.HttpMethod_Registered_{Registered: pb.HttpMethod_GET},
+					},
+					Scheme: &pb.Scheme{
+						Type: &pb.Scheme_Registered_{Registered: pb.Scheme_HTTP},
+					},
+					Authority: "books:7002",
+					Path:      "/books",
+				},
+			},
+		}, meta, pb.TapEvent_OUTBOUND),
+		util.CreateTapEvent(&pb.TapEvent_Http{
+			Event: &pb.TapEvent_Http_ResponseInit_{
+				ResponseInit: &pb.TapEvent_Http_ResponseInit{
+					Id:               id,
+					HttpStatus:       status,
+					SinceRequestInit: &duration.Duration{Nanos: int32(latency / 2)},
+				},
+			},
+		}, meta, pb.TapEvent_OUTBOUND),
+		util.CreateTapEvent(&pb.TapEvent_Http{
+			Event: &pb.TapEvent_Http_ResponseEnd_{
+				ResponseEnd: &pb.TapEvent_Http_ResponseEnd{
+					Id:               id,
+					SinceRequestInit: &duration.Duration{Nanos: int32(latency)},
+					ResponseBytes:    42,
+					Eos:              eos,
+				},
+			},
+		}, meta, pb.TapEvent_OUTBOUND),
+	}
+}
+
+func TestRenderTapEventsSummary(t *testing.T) {
+	tapClock = func() time.Time { return time.Date(2020, 9, 1, 12, 0, 0, 0, time.UTC) }
+	defer func() { tapClock = time.Now }()
+
+	events := [][]*pb.TapEvent{
+		summaryEvents(1, http.StatusOK, 3*time.Millisecond, nil),
+		summaryEvents(2, http.StatusOK, 4*time.Millisecond, nil),
+		summaryEvents(3, http.StatusInternalServerError, 120*time.Millisecond, nil),
+		summaryEvents(4, http.StatusOK, 800*time.Microsecond, &pb.Eos{
+			End: &pb.Eos_GrpcStatusCode{GrpcStatusCode: uint32(codes.Unavailable)},
+		}),
+	}
+	// interleave the streams, so that they have to be correlated
+	stream := []*pb.TapEvent{}
+	for i := 0; i < 3; i++ {
+		for _, e := range events {
+			stream = append(stream, e[i])
+		}
+	}
+
+	recorder := httptest.NewRecorder()
+	for _, event := range stream {
+		if err := protohttp.WriteProtoToHTTPResponse(recorder, event); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	writer := bytes.NewBufferString("")
+	err := renderTapEventsSummary(bufio.NewReader(recorder.Body), writer, "", time.Hour)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	goldenFileBytes, err := ioutil.ReadFile("testdata/tap_summary_output.golden")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expectedContent := string(goldenFileBytes)
+	actual := writer.String()
+	if expectedContent != actual {
+		t.Fatalf("Expected function to render:\n%s\bbut got:\n%s", expectedContent, actual)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("broken pipe")
+}
+
+func TestRenderTapEventsSummaryWriteError(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	for i := uint64(1); i <= 3; i++ {
+		for _, event := range summaryEvents(i, http.StatusOK, time.Millisecond, nil) {
+			if err := protohttp.WriteProtoToHTTPResponse(recorder, event); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		}
+	}
+
+	err := renderTapEventsSummary(bufio.NewReader(recorder.Body), failingWriter{}, "", time.Hour)
+	if err == nil || err.Error() != "broken pipe" {
+		t.Fatalf("Expected the write error to be returned, got %v", err)
+	}
+}
+
+func TestTapOptionsValidateSummary(t *testing.T) {
+	options := newTapOptions()
+	options.summary = true
+	options.output = wideOutput
+	if err := options.validate(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	options.output = jsonOutput
+	if err := options.validate(); err == nil {
+		t.Error("Expected --summary to be rejected with JSON output")
+	}
+
+	options.output = ""
+	options.summaryInterval = 0
+	if err := options.validate(); err == nil {
+		t.Error("Expected a zero --summary-interval to be rejected")
+	}
+}
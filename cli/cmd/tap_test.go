@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/linkerd/linkerd2/controller/api/util"
@@ -127,6 +128,9 @@ func busyTest(t *testing.T, output string) {
 	defer ts.Close()
 	kubeAPI.Config.Host = ts.URL
 
+	tapClock = func() time.Time { return time.Date(2020, 9, 1, 12, 0, 0, 0, time.UTC) }
+	defer func() { tapClock = time.Now }()
+
 	options := newTapOptions()
 	options.output = output
 
@@ -142,6 +146,10 @@ func busyTest(t *testing.T, output string) {
 		goldenFilePath = "testdata/tap_busy_output_wide.golden"
 	case jsonOutput:
 		goldenFilePath = "testdata/tap_busy_output_json.golden"
+	case harOutput:
+		goldenFilePath = "testdata/tap_busy_output_har.golden"
+	case otlpOutput:
+		goldenFilePath = "testdata/tap_busy_output_otlp.golden"
 	default:
 		goldenFilePath = "testdata/tap_busy_output.golden"
 	}
@@ -170,6 +178,14 @@ func TestRequestTapByResourceFromAPI(t *testing.T) {
 		busyTest(t, "json")
 	})
 
+	t.Run("Should render HAR busy response if everything went well", func(t *testing.T) {
+		busyTest(t, "har")
+	})
+
+	t.Run("Should render OTLP busy response if everything went well", func(t *testing.T) {
+		busyTest(t, "otlp-json")
+	})
+
 	t.Run("Should render empty response if no events returned", func(t *testing.T) {
 		resourceType := k8s.Pod
 		params := util.TapRequestParams{
@@ -406,3 +422,93 @@ func TestEventToString(t *testing.T) {
 		}
 	})
 }
+
+func TestTapCorrelator(t *testing.T) {
+	id := &pb.TapEvent_Http_StreamId{Base: 1, Stream: 2}
+	events := []*pb.TapEvent{
+		util.CreateTapEvent(&pb.TapEvent_Http{
+			Event: &pb.TapEvent_Http_RequestInit_{
+				RequestInit: &pb.TapEvent_Http_RequestInit{Id: id, Path: "/ping"},
+			},
+		}, map[string]string{"pod": "my-pod"}, pb.TapEvent_OUTBOUND),
+		util.CreateTapEvent(&pb.TapEvent_Http{
+			Event: &pb.TapEvent_Http_ResponseInit_{
+				ResponseInit: &pb.TapEvent_Http_ResponseInit{
+					Id:               id,
+					HttpStatus:       http.StatusOK,
+					SinceRequestInit: &duration.Duration{Nanos: 2000000},
+				},
+			},
+		}, map[string]string{}, pb.TapEvent_OUTBOUND),
+		util.CreateTapEvent(&pb.TapEvent_Http{
+			Event: &pb.TapEvent_Http_ResponseEnd_{
+				ResponseEnd: &pb.TapEvent_Http_ResponseEnd{
+					Id:               id,
+					SinceRequestInit: &duration.Duration{Seconds: 1, Nanos: 5000000},
+				},
+			},
+		}, map[string]string{}, pb.TapEvent_OUTBOUND),
+	}
+
+	c := newTapCorrelator()
+	for _, event := range events[:2] {
+		if exchange := c.add(event); exchange != nil {
+			t.Fatalf("Expected no exchange before the end event, got %+v", exchange)
+		}
+	}
+	exchange := c.add(events[2])
+	if exchange == nil {
+		t.Fatal("Expected an exchange once the end event was seen")
+	}
+
+	if exchange.requestInit.GetPath() != "/ping" {
+		t.Errorf("Expected request path /ping, got %s", exchange.requestInit.GetPath())
+	}
+	if exchange.responseInit.GetHttpStatus() != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", exchange.responseInit.GetHttpStatus())
+	}
+	if exchange.event.GetDestinationMeta().GetLabels()["pod"] != "my-pod" {
+		t.Errorf("Expected exchange to carry the request's metadata, got %+v", exchange.event.GetDestinationMeta())
+	}
+	if exchange.timeToFirstByte() != 2*time.Millisecond {
+		t.Errorf("Expected time to first byte of 2ms, got %s", exchange.timeToFirstByte())
+	}
+	if exchange.latency() != 1005*time.Millisecond {
+		t.Errorf("Expected latency of 1.005s, got %s", exchange.latency())
+	}
+	if len(c.exchanges) != 0 {
+		t.Errorf("Expected completed exchanges to be forgotten, got %d in flight", len(c.exchanges))
+	}
+}
+
+func TestTapCorrelatorEviction(t *testing.T) {
+	now := time.Date(2020, 9, 1, 12, 0, 0, 0, time.UTC)
+	tapClock = func() time.Time { return now }
+	defer func() { tapClock = time.Now }()
+
+	request := func(stream uint64) *pb.TapEvent {
+		return util.CreateTapEvent(&pb.TapEvent_Http{
+			Event: &pb.TapEvent_Http_RequestInit_{
+				RequestInit: &pb.TapEvent_Http_RequestInit{
+					Id: &pb.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+				},
+			},
+		}, map[string]string{}, pb.TapEvent_OUTBOUND)
+	}
+
+	c := newTapCorrelator()
+	c.add(request(1))
+	now = now.Add(tapExchangeTimeout + time.Second)
+	c.add(request(2))
+	if len(c.exchanges) != 1 {
+		t.Fatalf("Expected the timed out exchange to be evicted, got %d in flight", len(c.exchanges))
+	}
+
+	for i := uint64(3); i < maxTapExchanges+10; i++ {
+		now = now.Add(time.Millisecond)
+		c.add(request(i))
+	}
+	if len(c.exchanges) != maxTapExchanges {
+		t.Fatalf("Expected at most %d exchanges in flight, got %d", maxTapExchanges, len(c.exchanges))
+	}
+}
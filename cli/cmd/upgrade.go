@@ -308,6 +308,8 @@ func (options *upgradeOptions) validateAndBuild(ctx context.Context, stage strin
 	if err != nil {
 		return nil, fmt.Errorf("could not build install configuration: %s", err)
 	}
+	identity.Identity.AllowLegacyTokens = values.Identity.AllowLegacyTokens
+	identity.Identity.ExternalSigner = values.Identity.ExternalSigner
 	values.Identity = identity.Identity
 	values.Global.IdentityTrustAnchorsPEM = identity.TrustAnchorsPEM
 	values.Global.IdentityTrustDomain = identity.TrustDomain
@@ -347,7 +349,7 @@ func (options *upgradeOptions) validateAndBuild(ctx context.Context, stage strin
 		}
 		tapTLS = &charts.TLS{}
 	}
-	values.Tap = &charts.Tap{TLS: tapTLS}
+	values.Tap.TLS = tapTLS
 
 	values.Stage = stage
 
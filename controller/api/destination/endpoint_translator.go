@@ -3,6 +3,7 @@ package destination
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	pb "github.com/linkerd/linkerd2-proxy-api/go/destination"
 	"github.com/linkerd/linkerd2-proxy-api/go/net"
@@ -24,11 +25,22 @@ type endpointTranslator struct {
 	identityTrustDomain string
 	enableH2Upgrade     bool
 	nodeTopologyLabels  map[string]string
+	denyList            identityDenyList
 
 	availableEndpoints watcher.AddressSet
 	filteredSnapshot   watcher.AddressSet
 	stream             pb.Destination_GetServer
 	log                *logging.Entry
+
+	// Updates are received both from the endpoints watcher and the identity
+	// deny-list, so all access to the translator is synchronized by this mutex.
+	mu sync.Mutex
+}
+
+// identityDenyList is implemented by the sources of the identities whose
+// endpoints must not be served to proxies.
+type identityDenyList interface {
+	DeniedIdentity(identity string) (string, bool)
 }
 
 func newEndpointTranslator(
@@ -36,6 +48,7 @@ func newEndpointTranslator(
 	controllerNS string,
 	identityTrustDomain string,
 	enableH2Upgrade bool,
+	denyList identityDenyList,
 	service string,
 	srcNodeName string,
 	k8sClient kubernetes.Interface,
@@ -56,18 +69,22 @@ func newEndpointTranslator(
 	filteredSnapshot := newEmptyAddressSet()
 
 	return &endpointTranslator{
-		controllerNS,
-		identityTrustDomain,
-		enableH2Upgrade,
-		nodeTopologyLabels,
-		availableEndpoints,
-		filteredSnapshot,
-		stream,
-		log,
+		controllerNS:        controllerNS,
+		identityTrustDomain: identityTrustDomain,
+		enableH2Upgrade:     enableH2Upgrade,
+		nodeTopologyLabels:  nodeTopologyLabels,
+		denyList:            denyList,
+		availableEndpoints:  availableEndpoints,
+		filteredSnapshot:    filteredSnapshot,
+		stream:              stream,
+		log:                 log,
 	}
 }
 
 func (et *endpointTranslator) Add(set watcher.AddressSet) {
+	et.mu.Lock()
+	defer et.mu.Unlock()
+
 	for id, address := range set.Addresses {
 		et.availableEndpoints.Addresses[id] = address
 	}
@@ -76,6 +93,9 @@ func (et *endpointTranslator) Add(set watcher.AddressSet) {
 }
 
 func (et *endpointTranslator) Remove(set watcher.AddressSet) {
+	et.mu.Lock()
+	defer et.mu.Unlock()
+
 	for id := range set.Addresses {
 		delete(et.availableEndpoints.Addresses, id)
 	}
@@ -83,6 +103,16 @@ func (et *endpointTranslator) Remove(set watcher.AddressSet) {
 	et.sendFilteredUpdate(set)
 }
 
+// DenyListUpdated re-filters the available endpoints, so that the endpoints of
+// newly denied identities are removed from the client and those of identities
+// no longer denied are added back.
+func (et *endpointTranslator) DenyListUpdated() {
+	et.mu.Lock()
+	defer et.mu.Unlock()
+
+	et.sendFilteredUpdate(et.availableEndpoints)
+}
+
 func (et *endpointTranslator) sendFilteredUpdate(set watcher.AddressSet) {
 	et.availableEndpoints = watcher.AddressSet{
 		Addresses:       et.availableEndpoints.Addresses,
@@ -90,7 +120,7 @@ func (et *endpointTranslator) sendFilteredUpdate(set watcher.AddressSet) {
 		TopologicalPref: set.TopologicalPref,
 	}
 
-	filtered := et.filterAddresses()
+	filtered := et.filterDenied(et.filterAddresses())
 	diffAdd, diffRemove := et.diffEndpoints(filtered)
 
 	if len(diffAdd.Addresses) > 0 {
@@ -152,6 +182,30 @@ func (et *endpointTranslator) filterAddresses() watcher.AddressSet {
 	return newEmptyAddressSet()
 }
 
+// filterDenied removes the endpoints whose identity has been denied by an
+// identity deny-list, so that clients stop connecting to them.
+func (et *endpointTranslator) filterDenied(set watcher.AddressSet) watcher.AddressSet {
+	if et.denyList == nil {
+		return set
+	}
+
+	allowed := make(map[watcher.ID]watcher.Address)
+	for id, address := range set.Addresses {
+		if identity := et.identity(address); identity != "" {
+			if name, ok := et.denyList.DeniedIdentity(identity); ok {
+				et.log.Debugf("Filtering out endpoint %s: identity %s is denied by %s", id, identity, name)
+				continue
+			}
+		}
+		allowed[id] = address
+	}
+	return watcher.AddressSet{
+		Addresses:       allowed,
+		Labels:          set.Labels,
+		TopologicalPref: set.TopologicalPref,
+	}
+}
+
 // diffEndpoints calculates the difference between the filtered set of endpoints in the current (Add/Remove) operation
 // and the snapshot of previously filtered endpoints. This diff allows the client to receive only the endpoints that
 // satisfy the topological preference, by adding new endpoints and removing stale ones.
@@ -182,8 +236,25 @@ func (et *endpointTranslator) diffEndpoints(filtered watcher.AddressSet) (watche
 }
 
 func (et *endpointTranslator) NoEndpoints(exists bool) {
+	et.mu.Lock()
+	defer et.mu.Unlock()
+
 	et.log.Debugf("NoEndpoints(%+v)", exists)
 
+	// External workloads aren't published by the endpoints watcher, so they're
+	// still available when the service has no other endpoints.
+	workloads := make(map[watcher.ID]watcher.Address)
+	for id, address := range et.availableEndpoints.Addresses {
+		if address.ExternalWorkload != nil {
+			workloads[id] = address
+		}
+	}
+	if len(workloads) > 0 {
+		et.availableEndpoints.Addresses = workloads
+		et.sendFilteredUpdate(et.availableEndpoints)
+		return
+	}
+
 	u := &pb.Update{
 		Update: &pb.Update_NoEndpoints{
 			NoEndpoints: &pb.NoEndpoints{
@@ -207,6 +278,8 @@ func (et *endpointTranslator) sendClientAdd(set watcher.AddressSet) {
 		)
 		if address.Pod != nil {
 			wa, err = et.toWeightedAddr(address)
+		} else if address.ExternalWorkload != nil {
+			wa, err = et.toWorkloadWeightedAddr(address)
 		} else {
 			var authOverride *pb.AuthorityOverride
 			if address.AuthorityOverride != "" {
@@ -296,9 +369,40 @@ func (et *endpointTranslator) toAddr(address watcher.Address) (*net.TcpAddress,
 	}, nil
 }
 
-func (et *endpointTranslator) toWeightedAddr(address watcher.Address) (*pb.WeightedAddr, error) {
+// identity returns the TLS identity of the given address, or an empty string
+// if it can't participate in identity.
+func (et *endpointTranslator) identity(address watcher.Address) string {
+	if workload := address.ExternalWorkload; workload != nil {
+		// External workloads are issued certificates by this control plane's
+		// identity controller, for their service account.
+		if et.identityTrustDomain == "" {
+			return ""
+		}
+		return fmt.Sprintf("%s.%s.serviceaccount.identity.%s.%s", workload.Spec.ServiceAccount, workload.Namespace, et.controllerNS, et.identityTrustDomain)
+	}
+
+	if address.Pod == nil {
+		return address.Identity
+	}
+
+	// If the pod is controlled by the same Linkerd control plane, then it can
+	// participate in identity with peers.
+	//
+	// TODO this should be relaxed to match a trust domain annotation so that
+	// multiple meshes can participate in identity if they share trust roots.
 	controllerNS := address.Pod.Labels[k8s.ControllerNSLabel]
+	if et.identityTrustDomain == "" ||
+		controllerNS != et.controllerNS ||
+		address.Pod.Annotations[k8s.IdentityModeAnnotation] != k8s.IdentityModeDefault {
+		return ""
+	}
+
 	sa, ns := k8s.GetServiceAccountAndNS(address.Pod)
+	return fmt.Sprintf("%s.%s.serviceaccount.identity.%s.%s", sa, ns, controllerNS, et.identityTrustDomain)
+}
+
+func (et *endpointTranslator) toWeightedAddr(address watcher.Address) (*pb.WeightedAddr, error) {
+	controllerNS := address.Pod.Labels[k8s.ControllerNSLabel]
 	labels := k8s.GetPodLabels(address.OwnerKind, address.OwnerName, address.Pod)
 
 	// If the pod is controlled by any Linkerd control plane, then it can be hinted
@@ -312,17 +416,50 @@ func (et *endpointTranslator) toWeightedAddr(address watcher.Address) (*pb.Weigh
 		}
 	}
 
-	// If the pod is controlled by the same Linkerd control plane, then it can
-	// participate in identity with peers.
-	//
-	// TODO this should be relaxed to match a trust domain annotation so that
-	// multiple meshes can participate in identity if they share trust roots.
 	var identity *pb.TlsIdentity
-	if et.identityTrustDomain != "" &&
-		controllerNS == et.controllerNS &&
-		address.Pod.Annotations[k8s.IdentityModeAnnotation] == k8s.IdentityModeDefault {
+	if id := et.identity(address); id != "" {
+		identity = &pb.TlsIdentity{
+			Strategy: &pb.TlsIdentity_DnsLikeIdentity_{
+				DnsLikeIdentity: &pb.TlsIdentity_DnsLikeIdentity{
+					Name: id,
+				},
+			},
+		}
+	}
+
+	tcpAddr, err := et.toAddr(address)
+	if err != nil {
+		return nil, err
+	}
 
-		id := fmt.Sprintf("%s.%s.serviceaccount.identity.%s.%s", sa, ns, controllerNS, et.identityTrustDomain)
+	return &pb.WeightedAddr{
+		Addr:         tcpAddr,
+		Weight:       defaultWeight,
+		MetricLabels: labels,
+		TlsIdentity:  identity,
+		ProtocolHint: hint,
+	}, nil
+}
+
+// toWorkloadWeightedAddr translates the address of an external workload. Such
+// workloads run a proxy, so they're always hinted as handling H2.
+func (et *endpointTranslator) toWorkloadWeightedAddr(address watcher.Address) (*pb.WeightedAddr, error) {
+	labels := map[string]string{
+		address.OwnerKind: address.OwnerName,
+		"serviceaccount":  address.ExternalWorkload.Spec.ServiceAccount,
+	}
+
+	var hint *pb.ProtocolHint
+	if et.enableH2Upgrade {
+		hint = &pb.ProtocolHint{
+			Protocol: &pb.ProtocolHint_H2_{
+				H2: &pb.ProtocolHint_H2{},
+			},
+		}
+	}
+
+	var identity *pb.TlsIdentity
+	if id := et.identity(address); id != "" {
 		identity = &pb.TlsIdentity{
 			Strategy: &pb.TlsIdentity_DnsLikeIdentity_{
 				DnsLikeIdentity: &pb.TlsIdentity_DnsLikeIdentity{
@@ -11,6 +11,7 @@ import (
 	pb "github.com/linkerd/linkerd2-proxy-api/go/destination"
 	"github.com/linkerd/linkerd2-proxy-api/go/net"
 	"github.com/linkerd/linkerd2/controller/api/destination/watcher"
+	ewv1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/workload/v1alpha1"
 	pkgk8s "github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/addr"
 	"github.com/linkerd/linkerd2/pkg/k8s"
@@ -114,6 +115,23 @@ var (
 		Identity:          "some-identity",
 		AuthorityOverride: "some-auth.com:2",
 	}
+
+	externalWorkload = watcher.Address{
+		IP:   "1.1.1.5",
+		Port: 5,
+		ExternalWorkload: &ewv1alpha1.ExternalWorkload{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "vm-1",
+				Namespace: "ns",
+			},
+			Spec: ewv1alpha1.ExternalWorkloadSpec{
+				ServiceAccount: "vm",
+				WorkloadIPs:    []string{"1.1.1.5"},
+			},
+		},
+		OwnerKind: watcher.ExternalWorkloadOwnerKind,
+		OwnerName: "vm-1",
+	}
 )
 
 func makeEndpointTranslator(t *testing.T) (*mockDestinationGetServer, *endpointTranslator) {
@@ -146,6 +164,7 @@ metadata:
 		"linkerd",
 		"trust.domain",
 		true,
+		nil,
 		"service-name.service-ns",
 		"test-123",
 		k8sAPI.Client,
@@ -391,6 +410,121 @@ func TestEndpointTranslatorForPods(t *testing.T) {
 	})
 }
 
+func TestEndpointTranslatorForExternalWorkloads(t *testing.T) {
+	t.Run("Sends TlsIdentity, protocol hint and metric labels", func(t *testing.T) {
+		mockGetServer, translator := makeEndpointTranslator(t)
+
+		translator.Add(mkAddressSetForWorkloads(externalWorkload))
+
+		addrs := mockGetServer.updatesReceived[0].GetAdd().GetAddrs()
+		if len(addrs) != 1 {
+			t.Fatalf("Expected [1] address returned, got %v", addrs)
+		}
+		checkAddressAndWeight(t, addrs[0], externalWorkload)
+
+		expectedTLSIdentity := &pb.TlsIdentity_DnsLikeIdentity{
+			Name: "vm.ns.serviceaccount.identity.linkerd.trust.domain",
+		}
+		actualTLSIdentity := addrs[0].GetTlsIdentity().GetDnsLikeIdentity()
+		if !reflect.DeepEqual(actualTLSIdentity, expectedTLSIdentity) {
+			t.Fatalf("Expected TlsIdentity to be [%v] but was [%v]", expectedTLSIdentity, actualTLSIdentity)
+		}
+
+		if addrs[0].GetProtocolHint().GetH2() == nil {
+			t.Fatalf("Expected H2 protocol hint, got [%v]", addrs[0].GetProtocolHint())
+		}
+
+		expectedMetricLabels := map[string]string{
+			"externalworkload": "vm-1",
+			"serviceaccount":   "vm",
+		}
+		if !reflect.DeepEqual(addrs[0].MetricLabels, expectedMetricLabels) {
+			t.Fatalf("Expected metric labels to be [%v] but was [%v]", expectedMetricLabels, addrs[0].MetricLabels)
+		}
+	})
+
+	t.Run("Keeps external workloads when the service has no other endpoints", func(t *testing.T) {
+		mockGetServer, translator := makeEndpointTranslator(t)
+
+		translator.Add(mkAddressSetForPods(normalPod))
+		translator.Add(mkAddressSetForWorkloads(externalWorkload))
+		translator.NoEndpoints(true)
+
+		if len(mockGetServer.updatesReceived) != 3 {
+			t.Fatalf("Expecting [3] updates, got [%d]. Updates: %v", len(mockGetServer.updatesReceived), mockGetServer.updatesReceived)
+		}
+		removed := mockGetServer.updatesReceived[2].GetRemove().GetAddrs()
+		if len(removed) != 1 {
+			t.Fatalf("Expected [1] address removed, got %v", removed)
+		}
+		checkAddress(t, removed[0], normalPod)
+	})
+
+	t.Run("Sends NoEndpoints once external workloads are removed", func(t *testing.T) {
+		mockGetServer, translator := makeEndpointTranslator(t)
+
+		translator.Add(mkAddressSetForWorkloads(externalWorkload))
+		translator.Remove(mkAddressSetForWorkloads(externalWorkload))
+		translator.NoEndpoints(true)
+
+		last := mockGetServer.updatesReceived[len(mockGetServer.updatesReceived)-1]
+		if last.GetNoEndpoints() == nil {
+			t.Fatalf("Expected NoEndpoints to be sent, got %v", last)
+		}
+	})
+}
+
+type fakeDenyList map[string]bool
+
+func (d fakeDenyList) DeniedIdentity(identity string) (string, bool) {
+	return "compromised", d[identity]
+}
+
+func TestEndpointTranslatorWithDenyList(t *testing.T) {
+	denied := "serviceaccount-name.ns.serviceaccount.identity.linkerd.trust.domain"
+
+	t.Run("Does not send addresses of denied identities", func(t *testing.T) {
+		mockGetServer, translator := makeEndpointTranslator(t)
+		translator.denyList = fakeDenyList{denied: true}
+
+		translator.Add(mkAddressSetForPods(normalPod, tlsOptionalPod))
+
+		addrs := mockGetServer.updatesReceived[0].GetAdd().GetAddrs()
+		if len(addrs) != 1 {
+			t.Fatalf("Expected [1] address returned, got %v", addrs)
+		}
+		checkAddressAndWeight(t, addrs[0], tlsOptionalPod)
+	})
+
+	t.Run("Removes and re-adds addresses when the deny-list changes", func(t *testing.T) {
+		mockGetServer, translator := makeEndpointTranslator(t)
+		denyList := fakeDenyList{}
+		translator.denyList = denyList
+
+		translator.Add(mkAddressSetForPods(normalPod, tlsOptionalPod))
+
+		denyList[denied] = true
+		translator.DenyListUpdated()
+
+		delete(denyList, denied)
+		translator.DenyListUpdated()
+
+		if len(mockGetServer.updatesReceived) != 3 {
+			t.Fatalf("Expecting [3] updates, got [%d]. Updates: %v", len(mockGetServer.updatesReceived), mockGetServer.updatesReceived)
+		}
+		removed := mockGetServer.updatesReceived[1].GetRemove().GetAddrs()
+		if len(removed) != 1 {
+			t.Fatalf("Expected [1] address removed, got %v", removed)
+		}
+		checkAddress(t, removed[0], normalPod)
+		added := mockGetServer.updatesReceived[2].GetAdd().GetAddrs()
+		if len(added) != 1 {
+			t.Fatalf("Expected [1] address added back, got %v", added)
+		}
+		checkAddressAndWeight(t, added[0], normalPod)
+	})
+}
+
 func mkAddressSetForServices(gatewayAddresses ...watcher.Address) watcher.AddressSet {
 	set := watcher.AddressSet{
 		Addresses:       make(map[watcher.ServiceID]watcher.Address),
@@ -424,6 +558,22 @@ func mkAddressSetForPods(podAddresses ...watcher.Address) watcher.AddressSet {
 	return set
 }
 
+func mkAddressSetForWorkloads(workloadAddresses ...watcher.Address) watcher.AddressSet {
+	set := watcher.AddressSet{
+		Addresses:       make(map[watcher.ID]watcher.Address),
+		Labels:          map[string]string{"service": "service-name", "namespace": "service-ns"},
+		TopologicalPref: []string{},
+	}
+	for _, a := range workloadAddresses {
+		id := watcher.ID{
+			Name:      fmt.Sprintf("%s-%s-%s-%d", watcher.ExternalWorkloadOwnerKind, a.ExternalWorkload.Name, a.IP, a.Port),
+			Namespace: a.ExternalWorkload.Namespace,
+		}
+		set.Addresses[id] = a
+	}
+	return set
+}
+
 func checkAddressAndWeight(t *testing.T, actual *pb.WeightedAddr, expected watcher.Address) {
 	checkAddress(t, actual.GetAddr(), expected)
 	if actual.GetWeight() != defaultWeight {
@@ -0,0 +1,50 @@
+package destination
+
+import (
+	"github.com/linkerd/linkerd2/controller/api/destination/watcher"
+	policyPb "github.com/linkerd/linkerd2/controller/gen/controller/policy"
+	logging "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// policyServer serves the InboundPolicies API, through which proxies learn
+// which clients may connect to the ports of their pod.
+type policyServer struct {
+	authorizations *watcher.ServerAuthorizationWatcher
+
+	controllerNS        string
+	identityTrustDomain string
+
+	log      *logging.Entry
+	shutdown <-chan struct{}
+}
+
+func (s *policyServer) Watch(spec *policyPb.PortSpec, stream policyPb.InboundPolicies_WatchServer) error {
+	log := s.log
+	client, _ := peer.FromContext(stream.Context())
+	if client != nil {
+		log = log.WithField("remote", client.Addr)
+	}
+	log.Debugf("Watch(%+v)", spec)
+
+	if spec.GetNamespace() == "" || spec.GetPod() == "" || spec.GetPort() == 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid port spec: %+v", spec)
+	}
+
+	translator := newPolicyTranslator(s.controllerNS, s.identityTrustDomain, stream, log)
+
+	pod := watcher.PodID{Namespace: spec.GetNamespace(), Name: spec.GetPod()}
+	port := watcher.Port(spec.GetPort())
+	s.authorizations.Subscribe(pod, port, translator)
+	defer s.authorizations.Unsubscribe(pod, port, translator)
+
+	select {
+	case <-s.shutdown:
+	case <-stream.Context().Done():
+		log.Debugf("Watch(%+v) cancelled", spec)
+	}
+
+	return nil
+}
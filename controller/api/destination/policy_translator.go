@@ -0,0 +1,89 @@
+package destination
+
+import (
+	"fmt"
+
+	authz "github.com/linkerd/linkerd2/controller/gen/apis/policy/v1alpha1"
+	policyPb "github.com/linkerd/linkerd2/controller/gen/controller/policy"
+	"github.com/linkerd/linkerd2/pkg/policy"
+	logging "github.com/sirupsen/logrus"
+)
+
+// implements the AuthorizationUpdateListener interface
+type policyTranslator struct {
+	controllerNS        string
+	identityTrustDomain string
+	stream              policyPb.InboundPolicies_WatchServer
+	log                 *logging.Entry
+}
+
+func newPolicyTranslator(
+	controllerNS string,
+	identityTrustDomain string,
+	stream policyPb.InboundPolicies_WatchServer,
+	log *logging.Entry,
+) *policyTranslator {
+	return &policyTranslator{
+		controllerNS:        controllerNS,
+		identityTrustDomain: identityTrustDomain,
+		stream:              stream,
+		log:                 log.WithField("component", "policy-translator"),
+	}
+}
+
+func (pt *policyTranslator) Update(authorizations []*authz.ServerAuthorization) {
+	inboundPolicy := &policyPb.InboundPolicy{
+		DefaultAllow:   len(authorizations) == 0,
+		Authorizations: []*policyPb.Authorization{},
+	}
+	for _, serverAuthz := range authorizations {
+		inboundPolicy.Authorizations = append(inboundPolicy.Authorizations, pt.toAuthorization(serverAuthz))
+	}
+
+	pt.log.Debugf("Sending inbound policy update: %+v", inboundPolicy)
+	if err := pt.stream.Send(inboundPolicy); err != nil {
+		pt.log.Errorf("Failed to send inbound policy: %s", err)
+	}
+}
+
+// toAuthorization resolves the service accounts and namespaces of a
+// ServerAuthorization into the identities and identity suffixes they are issued
+// by this control plane.
+func (pt *policyTranslator) toAuthorization(serverAuthz *authz.ServerAuthorization) *policyPb.Authorization {
+	authorization := &policyPb.Authorization{
+		Name:            serverAuthz.Name,
+		Unauthenticated: serverAuthz.Spec.Client.Unauthenticated,
+		Identities:      []string{},
+		Suffixes:        []string{},
+	}
+
+	meshTLS := serverAuthz.Spec.Client.MeshTLS
+	if meshTLS == nil {
+		return authorization
+	}
+	for _, id := range meshTLS.Identities {
+		if id == policy.AnyIdentity {
+			authorization.Suffixes = append(authorization.Suffixes, "")
+			continue
+		}
+		authorization.Identities = append(authorization.Identities, id)
+	}
+
+	// Without a trust domain, no identities are issued by this control plane.
+	if pt.identityTrustDomain == "" {
+		return authorization
+	}
+	for _, sa := range meshTLS.ServiceAccounts {
+		ns := sa.Namespace
+		if ns == "" {
+			ns = serverAuthz.Namespace
+		}
+		authorization.Identities = append(authorization.Identities,
+			fmt.Sprintf("%s.%s.serviceaccount.identity.%s.%s", sa.Name, ns, pt.controllerNS, pt.identityTrustDomain))
+	}
+	for _, ns := range meshTLS.Namespaces {
+		authorization.Suffixes = append(authorization.Suffixes,
+			fmt.Sprintf("%s.serviceaccount.identity.%s.%s", ns, pt.controllerNS, pt.identityTrustDomain))
+	}
+	return authorization
+}
@@ -0,0 +1,91 @@
+package destination
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/linkerd/linkerd2/controller/api/util"
+	authz "github.com/linkerd/linkerd2/controller/gen/apis/policy/v1alpha1"
+	policyPb "github.com/linkerd/linkerd2/controller/gen/controller/policy"
+	logging "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type mockInboundPoliciesWatchServer struct {
+	util.MockServerStream
+	policiesReceived []*policyPb.InboundPolicy
+}
+
+func (m *mockInboundPoliciesWatchServer) Send(policy *policyPb.InboundPolicy) error {
+	m.policiesReceived = append(m.policiesReceived, policy)
+	return nil
+}
+
+func TestPolicyTranslator(t *testing.T) {
+	t.Run("Sends a default-allow policy when no ServerAuthorization selects the port", func(t *testing.T) {
+		mockWatchServer := &mockInboundPoliciesWatchServer{}
+		translator := newPolicyTranslator("linkerd", "trust.domain", mockWatchServer, logging.WithField("test", t.Name()))
+
+		translator.Update(nil)
+
+		expected := &policyPb.InboundPolicy{DefaultAllow: true, Authorizations: []*policyPb.Authorization{}}
+		if len(mockWatchServer.policiesReceived) != 1 || !proto.Equal(mockWatchServer.policiesReceived[0], expected) {
+			t.Fatalf("Expected [%v], got %v", expected, mockWatchServer.policiesReceived)
+		}
+	})
+
+	t.Run("Resolves service accounts and namespaces into identities", func(t *testing.T) {
+		mockWatchServer := &mockInboundPoliciesWatchServer{}
+		translator := newPolicyTranslator("linkerd", "trust.domain", mockWatchServer, logging.WithField("test", t.Name()))
+
+		translator.Update([]*authz.ServerAuthorization{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "admin", Namespace: "emojivoto"},
+				Spec: authz.ServerAuthorizationSpec{
+					Client: authz.Client{Unauthenticated: true},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "emojivoto"},
+				Spec: authz.ServerAuthorizationSpec{
+					Client: authz.Client{
+						MeshTLS: &authz.MeshTLS{
+							Identities: []string{"vote-bot.emojivoto.serviceaccount.identity.linkerd.trust.domain", "*"},
+							ServiceAccounts: []authz.ServiceAccountName{
+								{Name: "web"},
+								{Name: "prometheus", Namespace: "monitoring"},
+							},
+							Namespaces: []string{"linkerd"},
+						},
+					},
+				},
+			},
+		})
+
+		expected := &policyPb.InboundPolicy{
+			Authorizations: []*policyPb.Authorization{
+				{
+					Name:            "admin",
+					Unauthenticated: true,
+					Identities:      []string{},
+					Suffixes:        []string{},
+				},
+				{
+					Name: "web",
+					Identities: []string{
+						"vote-bot.emojivoto.serviceaccount.identity.linkerd.trust.domain",
+						"web.emojivoto.serviceaccount.identity.linkerd.trust.domain",
+						"prometheus.monitoring.serviceaccount.identity.linkerd.trust.domain",
+					},
+					Suffixes: []string{
+						"",
+						"linkerd.serviceaccount.identity.linkerd.trust.domain",
+					},
+				},
+			},
+		}
+		if len(mockWatchServer.policiesReceived) != 1 || !proto.Equal(mockWatchServer.policiesReceived[0], expected) {
+			t.Fatalf("Expected [%v], got %v", expected, mockWatchServer.policiesReceived)
+		}
+	})
+}
@@ -9,6 +9,8 @@ import (
 
 	pb "github.com/linkerd/linkerd2-proxy-api/go/destination"
 	"github.com/linkerd/linkerd2/controller/api/destination/watcher"
+	policyPb "github.com/linkerd/linkerd2/controller/gen/controller/policy"
+	"github.com/linkerd/linkerd2/controller/identity"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
 	logging "github.com/sirupsen/logrus"
@@ -24,6 +26,8 @@ type (
 		profiles      *watcher.ProfileWatcher
 		trafficSplits *watcher.TrafficSplitWatcher
 		ips           *watcher.IPWatcher
+		denyList      *identity.DenyList
+		workloads     *watcher.ExternalWorkloadWatcher
 
 		enableH2Upgrade     bool
 		controllerNS        string
@@ -67,11 +71,23 @@ func NewServer(
 	trafficSplits := watcher.NewTrafficSplitWatcher(k8sAPI, log)
 	ips := watcher.NewIPWatcher(k8sAPI, endpoints, log)
 
+	var denyList *identity.DenyList
+	if k8sAPI.IDLAvailable() {
+		denyList = identity.NewDenyList(k8sAPI, log)
+	}
+
+	var workloads *watcher.ExternalWorkloadWatcher
+	if k8sAPI.EWAvailable() {
+		workloads = watcher.NewExternalWorkloadWatcher(k8sAPI, log)
+	}
+
 	srv := server{
 		endpoints,
 		profiles,
 		trafficSplits,
 		ips,
+		denyList,
+		workloads,
 		enableH2Upgrade,
 		controllerNS,
 		identityTrustDomain,
@@ -84,6 +100,16 @@ func NewServer(
 	s := prometheus.NewGrpcServer()
 	// linkerd2-proxy-api/destination.Destination (proxy-facing)
 	pb.RegisterDestinationServer(s, &srv)
+	if k8sAPI.AUTHZAvailable() {
+		// linkerd2.controller.policy.InboundPolicies (proxy-facing)
+		policyPb.RegisterInboundPoliciesServer(s, &policyServer{
+			watcher.NewServerAuthorizationWatcher(k8sAPI, log),
+			controllerNS,
+			identityTrustDomain,
+			log,
+			shutdown,
+		})
+	}
 	return s
 }
 
@@ -101,11 +127,16 @@ func (s *server) Get(dest *pb.GetDestination, stream pb.Destination_GetServer) e
 		log.Debugf("Dest token: %v", token)
 	}
 
+	var denyList identityDenyList
+	if s.denyList != nil {
+		denyList = s.denyList
+	}
 	translator := newEndpointTranslator(
 		stream.Context(),
 		s.controllerNS,
 		s.identityTrustDomain,
 		s.enableH2Upgrade,
+		denyList,
 		dest.GetPath(),
 		token.NodeName,
 		s.k8sAPI.Client,
@@ -120,6 +151,11 @@ func (s *server) Get(dest *pb.GetDestination, stream pb.Destination_GetServer) e
 		return status.Errorf(codes.InvalidArgument, "Invalid authority: %s", dest.GetPath())
 	}
 
+	if s.denyList != nil {
+		s.denyList.Subscribe(translator)
+		defer s.denyList.Unsubscribe(translator)
+	}
+
 	if ip := net.ParseIP(host); ip != nil {
 		err := s.ips.Subscribe(host, port, translator)
 		if err != nil {
@@ -146,6 +182,13 @@ func (s *server) Get(dest *pb.GetDestination, stream pb.Destination_GetServer) e
 			return err
 		}
 		defer s.endpoints.Unsubscribe(service, port, instanceID, translator)
+
+		// Requests for a specific instance of a service only target pods, so
+		// they don't need the service's external workloads.
+		if s.workloads != nil && instanceID == "" {
+			s.workloads.Subscribe(service, port, translator)
+			defer s.workloads.Unsubscribe(service, port, translator)
+		}
 	}
 
 	select {
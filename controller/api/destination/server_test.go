@@ -114,6 +114,8 @@ spec:
 		profiles,
 		trafficSplits,
 		ips,
+		nil,
+		nil,
 		false,
 		"linkerd",
 		"trust.domain",
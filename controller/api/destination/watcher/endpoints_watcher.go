@@ -7,6 +7,7 @@ import (
 	"strings"
 	"sync"
 
+	ewv1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/workload/v1alpha1"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	consts "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/prometheus/client_golang/prometheus"
@@ -44,6 +45,7 @@ type (
 		IP                string
 		Port              Port
 		Pod               *corev1.Pod
+		ExternalWorkload  *ewv1alpha1.ExternalWorkload
 		OwnerName         string
 		OwnerKind         string
 		Identity          string
@@ -1017,6 +1019,10 @@ func addressChanged(oldAddress Address, newAddress Address) bool {
 		// if these addresses are owned by pods we can check the resource versions
 		return oldAddress.Pod.ResourceVersion != newAddress.Pod.ResourceVersion
 	}
+
+	if oldAddress.ExternalWorkload != nil && newAddress.ExternalWorkload != nil {
+		return oldAddress.ExternalWorkload.ResourceVersion != newAddress.ExternalWorkload.ResourceVersion
+	}
 	return false
 }
 
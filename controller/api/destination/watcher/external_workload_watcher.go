@@ -0,0 +1,327 @@
+package watcher
+
+import (
+	"fmt"
+	"sync"
+
+	ewv1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/workload/v1alpha1"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	logging "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ExternalWorkloadOwnerKind is the owner kind reported for the addresses of
+// external workloads.
+const ExternalWorkloadOwnerKind = "externalworkload"
+
+type (
+	// ExternalWorkloadWatcher watches all ExternalWorkloads and Services in the
+	// Kubernetes cluster. Listeners can subscribe to a particular service and
+	// port and ExternalWorkloadWatcher will publish the addresses of the
+	// ExternalWorkloads selected by that service, and all future changes to
+	// them. These addresses complement those published by the EndpointsWatcher,
+	// as Kubernetes doesn't add ExternalWorkloads to the service's Endpoints.
+	ExternalWorkloadWatcher struct {
+		publishers map[workloadPublisherKey]*workloadPublisher
+		k8sAPI     *k8s.API
+
+		log          *logging.Entry
+		sync.RWMutex // This mutex protects modification of the map itself.
+	}
+
+	workloadPublisherKey struct {
+		id   ServiceID
+		port Port
+	}
+
+	// workloadPublisher represents a service and port. It keeps the addresses
+	// of the ExternalWorkloads selected by the service, and publishes diffs to
+	// all listeners when either the service or the workloads change.
+	workloadPublisher struct {
+		id     ServiceID
+		port   Port
+		k8sAPI *k8s.API
+		log    *logging.Entry
+
+		addresses AddressSet
+		listeners []EndpointUpdateListener
+		// All access to the workloadPublisher is explicitly synchronized by this
+		// mutex.
+		sync.Mutex
+	}
+)
+
+// NewExternalWorkloadWatcher creates an ExternalWorkloadWatcher and begins
+// watching the k8sAPI for service and ExternalWorkload changes.
+func NewExternalWorkloadWatcher(k8sAPI *k8s.API, log *logging.Entry) *ExternalWorkloadWatcher {
+	ew := &ExternalWorkloadWatcher{
+		publishers: make(map[workloadPublisherKey]*workloadPublisher),
+		k8sAPI:     k8sAPI,
+		log:        log.WithField("component", "external-workload-watcher"),
+	}
+
+	k8sAPI.Svc().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    ew.updateService,
+		DeleteFunc: ew.updateService,
+		UpdateFunc: func(_, obj interface{}) { ew.updateService(obj) },
+	})
+
+	k8sAPI.EW().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    ew.updateWorkload,
+		DeleteFunc: ew.updateWorkload,
+		UpdateFunc: func(_, obj interface{}) { ew.updateWorkload(obj) },
+	})
+
+	return ew
+}
+
+///////////////////////////////
+/// ExternalWorkloadWatcher ///
+///////////////////////////////
+
+// Subscribe to a service and port.
+// The provided listener will be updated each time the addresses of the
+// external workloads selected by the service change.
+func (ew *ExternalWorkloadWatcher) Subscribe(id ServiceID, port Port, listener EndpointUpdateListener) error {
+	ew.log.Debugf("Establishing watch on external workloads of [%s:%d]", id, port)
+
+	publisher := ew.getOrNewPublisher(id, port)
+
+	publisher.subscribe(listener)
+	return nil
+}
+
+// Unsubscribe removes a listener from the subscribers list for this service
+// and port.
+func (ew *ExternalWorkloadWatcher) Unsubscribe(id ServiceID, port Port, listener EndpointUpdateListener) {
+	ew.log.Debugf("Stopping watch on external workloads of [%s:%d]", id, port)
+
+	ew.Lock()
+	defer ew.Unlock()
+
+	key := workloadPublisherKey{id, port}
+	publisher, ok := ew.publishers[key]
+	if !ok {
+		ew.log.Errorf("Cannot unsubscribe from unknown service [%s:%d]", id, port)
+		return
+	}
+	if publisher.unsubscribe(listener) == 0 {
+		delete(ew.publishers, key)
+	}
+}
+
+func (ew *ExternalWorkloadWatcher) updateService(obj interface{}) {
+	service, ok := obj.(*corev1.Service)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			ew.log.Errorf("couldn't get object from DeletedFinalStateUnknown %#v", obj)
+			return
+		}
+		service, ok = tombstone.Obj.(*corev1.Service)
+		if !ok {
+			ew.log.Errorf("DeletedFinalStateUnknown contained object that is not a Service %#v", obj)
+			return
+		}
+	}
+
+	id := ServiceID{Namespace: service.Namespace, Name: service.Name}
+	for _, publisher := range ew.getPublishers(func(key workloadPublisherKey) bool { return key.id == id }) {
+		publisher.refresh()
+	}
+}
+
+func (ew *ExternalWorkloadWatcher) updateWorkload(obj interface{}) {
+	workload, ok := obj.(*ewv1alpha1.ExternalWorkload)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			ew.log.Errorf("couldn't get object from DeletedFinalStateUnknown %#v", obj)
+			return
+		}
+		workload, ok = tombstone.Obj.(*ewv1alpha1.ExternalWorkload)
+		if !ok {
+			ew.log.Errorf("DeletedFinalStateUnknown contained object that is not an ExternalWorkload %#v", obj)
+			return
+		}
+	}
+
+	// A workload's labels may have changed, so every service in its namespace
+	// may have gained or lost it.
+	ns := workload.Namespace
+	for _, publisher := range ew.getPublishers(func(key workloadPublisherKey) bool { return key.id.Namespace == ns }) {
+		publisher.refresh()
+	}
+}
+
+func (ew *ExternalWorkloadWatcher) getOrNewPublisher(id ServiceID, port Port) *workloadPublisher {
+	ew.Lock()
+	defer ew.Unlock()
+
+	key := workloadPublisherKey{id, port}
+	publisher, ok := ew.publishers[key]
+	if !ok {
+		publisher = &workloadPublisher{
+			id:     id,
+			port:   port,
+			k8sAPI: ew.k8sAPI,
+			log: ew.log.WithFields(logging.Fields{
+				"component": "external-workload-publisher",
+				"ns":        id.Namespace,
+				"svc":       id.Name,
+				"port":      port,
+			}),
+		}
+		publisher.addresses = publisher.workloadAddresses()
+		ew.publishers[key] = publisher
+	}
+	return publisher
+}
+
+func (ew *ExternalWorkloadWatcher) getPublishers(matches func(workloadPublisherKey) bool) []*workloadPublisher {
+	ew.RLock()
+	defer ew.RUnlock()
+
+	publishers := []*workloadPublisher{}
+	for key, publisher := range ew.publishers {
+		if matches(key) {
+			publishers = append(publishers, publisher)
+		}
+	}
+	return publishers
+}
+
+/////////////////////////
+/// workloadPublisher ///
+/////////////////////////
+
+func (wp *workloadPublisher) subscribe(listener EndpointUpdateListener) {
+	wp.Lock()
+	defer wp.Unlock()
+
+	wp.listeners = append(wp.listeners, listener)
+	if len(wp.addresses.Addresses) > 0 {
+		listener.Add(wp.addresses)
+	}
+}
+
+// unsubscribe returns the number of listeners left.
+func (wp *workloadPublisher) unsubscribe(listener EndpointUpdateListener) int {
+	wp.Lock()
+	defer wp.Unlock()
+
+	for i, e := range wp.listeners {
+		if e == listener {
+			n := len(wp.listeners)
+			wp.listeners[i] = wp.listeners[n-1]
+			wp.listeners[n-1] = nil
+			wp.listeners = wp.listeners[:n-1]
+			break
+		}
+	}
+	return len(wp.listeners)
+}
+
+func (wp *workloadPublisher) refresh() {
+	wp.Lock()
+	defer wp.Unlock()
+
+	newAddressSet := wp.workloadAddresses()
+	add, remove := diffAddresses(wp.addresses, newAddressSet)
+	for _, listener := range wp.listeners {
+		if len(remove.Addresses) > 0 {
+			listener.Remove(remove)
+		}
+		if len(add.Addresses) > 0 {
+			listener.Add(add)
+		}
+	}
+	wp.addresses = newAddressSet
+}
+
+// workloadAddresses returns the addresses of the ExternalWorkloads matching
+// the service's selector, on the port the service's port targets.
+func (wp *workloadPublisher) workloadAddresses() AddressSet {
+	set := AddressSet{
+		Addresses: make(map[ID]Address),
+		Labels:    map[string]string{service: wp.id.Name, namespace: wp.id.Namespace},
+	}
+
+	svc, err := wp.k8sAPI.Svc().Lister().Services(wp.id.Namespace).Get(wp.id.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			wp.log.Errorf("error getting service: %s", err)
+		}
+		return set
+	}
+	// Services without selectors have their endpoints managed by hand, so
+	// they never select workloads.
+	if len(svc.Spec.Selector) == 0 {
+		return set
+	}
+
+	selector := k8slabels.Set(svc.Spec.Selector).AsSelector()
+	workloads, err := wp.k8sAPI.EW().Lister().ExternalWorkloads(wp.id.Namespace).List(selector)
+	if err != nil {
+		wp.log.Errorf("error listing external workloads: %s", err)
+		return set
+	}
+
+	targetPort := getWorkloadTargetPort(svc, wp.port)
+	for _, workload := range workloads {
+		port := resolveWorkloadPort(workload, targetPort)
+		if port == Port(0) {
+			continue
+		}
+		for _, ip := range workload.Spec.WorkloadIPs {
+			id := ID{
+				Namespace: workload.Namespace,
+				Name:      fmt.Sprintf("%s-%s-%s-%d", ExternalWorkloadOwnerKind, workload.Name, ip, port),
+			}
+			set.Addresses[id] = Address{
+				IP:               ip,
+				Port:             port,
+				ExternalWorkload: workload,
+				OwnerName:        workload.Name,
+				OwnerKind:        ExternalWorkloadOwnerKind,
+				TopologyLabels:   make(map[string]string),
+			}
+		}
+	}
+	return set
+}
+
+// getWorkloadTargetPort returns the target port of the service's port
+// matching the specified port. Unlike pods, workloads don't appear in the
+// service's Endpoints, so the target port isn't resolved by Kubernetes.
+func getWorkloadTargetPort(service *corev1.Service, port Port) namedPort {
+	for _, portSpec := range service.Spec.Ports {
+		if portSpec.Port == int32(port) {
+			if portSpec.TargetPort.Type == intstr.Int && portSpec.TargetPort.IntVal == 0 {
+				break
+			}
+			return portSpec.TargetPort
+		}
+	}
+	return intstr.FromInt(int(port))
+}
+
+// resolveWorkloadPort returns the port of the workload the target port refers
+// to, or 0 if the workload doesn't serve a port with that name.
+func resolveWorkloadPort(workload *ewv1alpha1.ExternalWorkload, targetPort namedPort) Port {
+	switch targetPort.Type {
+	case intstr.Int:
+		return Port(targetPort.IntVal)
+	case intstr.String:
+		for _, p := range workload.Spec.Ports {
+			if p.Name == targetPort.StrVal {
+				return Port(p.Port)
+			}
+		}
+	}
+	return Port(0)
+}
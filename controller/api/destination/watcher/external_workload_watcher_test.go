@@ -0,0 +1,218 @@
+package watcher
+
+import (
+	"testing"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	logging "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testWorkload = `
+apiVersion: workload.linkerd.io/v1alpha1
+kind: ExternalWorkload
+metadata:
+  name: vm-1
+  namespace: ns
+  labels:
+    app: name1
+spec:
+  serviceAccount: vm
+  workloadIPs:
+  - 10.1.0.5
+  - 10.1.0.6
+  ports:
+  - name: http
+    port: 8080`
+
+func TestExternalWorkloadWatcher(t *testing.T) {
+	for _, tt := range []struct {
+		name              string
+		k8sConfigs        []string
+		id                ServiceID
+		port              Port
+		expectedAddresses []string
+	}{
+		{
+			name: "service targeting a named port",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: name1
+  namespace: ns
+spec:
+  selector:
+    app: name1
+  ports:
+  - port: 80
+    targetPort: http`, testWorkload},
+			id:                ServiceID{Name: "name1", Namespace: "ns"},
+			port:              80,
+			expectedAddresses: []string{"10.1.0.5:8080", "10.1.0.6:8080"},
+		},
+		{
+			name: "service targeting a numbered port",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: name1
+  namespace: ns
+spec:
+  selector:
+    app: name1
+  ports:
+  - port: 80
+    targetPort: 9090`, testWorkload},
+			id:                ServiceID{Name: "name1", Namespace: "ns"},
+			port:              80,
+			expectedAddresses: []string{"10.1.0.5:9090", "10.1.0.6:9090"},
+		},
+		{
+			name: "service targeting a port the workload doesn't name",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: name1
+  namespace: ns
+spec:
+  selector:
+    app: name1
+  ports:
+  - port: 80
+    targetPort: grpc`, testWorkload},
+			id:                ServiceID{Name: "name1", Namespace: "ns"},
+			port:              80,
+			expectedAddresses: []string{},
+		},
+		{
+			name: "service not selecting the workload",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: name2
+  namespace: ns
+spec:
+  selector:
+    app: name2
+  ports:
+  - port: 8080`, testWorkload},
+			id:                ServiceID{Name: "name2", Namespace: "ns"},
+			port:              8080,
+			expectedAddresses: []string{},
+		},
+		{
+			name: "service without a selector",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: name1
+  namespace: ns
+spec:
+  ports:
+  - port: 8080`, testWorkload},
+			id:                ServiceID{Name: "name1", Namespace: "ns"},
+			port:              8080,
+			expectedAddresses: []string{},
+		},
+		{
+			name:              "nonexistent service",
+			k8sConfigs:        []string{testWorkload},
+			id:                ServiceID{Name: "name1", Namespace: "ns"},
+			port:              8080,
+			expectedAddresses: []string{},
+		},
+	} {
+		tt := tt // pin
+		t.Run(tt.name, func(t *testing.T) {
+			k8sAPI, err := k8s.NewFakeAPI(tt.k8sConfigs...)
+			if err != nil {
+				t.Fatalf("NewFakeAPI returned an error: %s", err)
+			}
+
+			watcher := NewExternalWorkloadWatcher(k8sAPI, logging.WithField("test", t.Name()))
+
+			k8sAPI.Sync(nil)
+
+			listener := newBufferingEndpointListener()
+
+			err = watcher.Subscribe(tt.id, tt.port, listener)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			listener.ExpectAdded(tt.expectedAddresses, t)
+			if listener.endpointsAreNotCalled() {
+				t.Fatal("NoEndpoints shouldn't be called for external workloads")
+			}
+		})
+	}
+}
+
+func TestExternalWorkloadWatcherUpdates(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: name1
+  namespace: ns
+spec:
+  selector:
+    app: name1
+  ports:
+  - port: 80
+    targetPort: http`, testWorkload)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+
+	watcher := NewExternalWorkloadWatcher(k8sAPI, logging.WithField("test", t.Name()))
+
+	k8sAPI.Sync(nil)
+
+	listener := newBufferingEndpointListener()
+	if err := watcher.Subscribe(ServiceID{Name: "name1", Namespace: "ns"}, 80, listener); err != nil {
+		t.Fatal(err)
+	}
+	listener.ExpectAdded([]string{"10.1.0.5:8080", "10.1.0.6:8080"}, t)
+
+	workload, err := k8sAPI.EW().Lister().ExternalWorkloads("ns").Get("vm-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Moving the workload to a new IP replaces its address.
+	moved := workload.DeepCopy()
+	moved.ResourceVersion = "2"
+	moved.Spec.WorkloadIPs = []string{"10.1.0.5", "10.1.0.7"}
+	if err := k8sAPI.EW().Informer().GetStore().Update(moved); err != nil {
+		t.Fatal(err)
+	}
+	watcher.updateWorkload(moved)
+	listener.ExpectAdded([]string{"10.1.0.5:8080", "10.1.0.5:8080", "10.1.0.6:8080", "10.1.0.7:8080"}, t)
+	listener.ExpectRemoved([]string{"10.1.0.6:8080"}, t)
+
+	// Relabelling the workload removes it from the service.
+	relabelled := moved.DeepCopy()
+	relabelled.ObjectMeta = metav1.ObjectMeta{
+		Name:            moved.Name,
+		Namespace:       moved.Namespace,
+		ResourceVersion: "3",
+		Labels:          map[string]string{"app": "other"},
+	}
+	if err := k8sAPI.EW().Informer().GetStore().Update(relabelled); err != nil {
+		t.Fatal(err)
+	}
+	watcher.updateWorkload(relabelled)
+	listener.ExpectRemoved([]string{"10.1.0.5:8080", "10.1.0.6:8080", "10.1.0.7:8080"}, t)
+
+	// Unsubscribing the last listener drops the publisher.
+	watcher.Unsubscribe(ServiceID{Name: "name1", Namespace: "ns"}, 80, listener)
+	if len(watcher.publishers) != 0 {
+		t.Fatalf("Expected no publishers, got %d", len(watcher.publishers))
+	}
+}
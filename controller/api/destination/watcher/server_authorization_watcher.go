@@ -0,0 +1,301 @@
+package watcher
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	authz "github.com/linkerd/linkerd2/controller/gen/apis/policy/v1alpha1"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	"github.com/linkerd/linkerd2/pkg/policy"
+	logging "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+type (
+	// ServerAuthorizationWatcher watches all ServerAuthorizations and pods in
+	// the Kubernetes cluster. Listeners can subscribe to a port of a pod and
+	// ServerAuthorizationWatcher will publish the ServerAuthorizations that
+	// select that port, and all future changes to them.
+	ServerAuthorizationWatcher struct {
+		publishers map[authorizationPublisherKey]*authorizationPublisher
+		k8sAPI     *k8s.API
+
+		log          *logging.Entry
+		sync.RWMutex // This mutex protects modification of the map itself.
+	}
+
+	authorizationPublisherKey struct {
+		pod  PodID
+		port Port
+	}
+
+	// authorizationPublisher represents a port of a pod. It keeps the
+	// ServerAuthorizations selecting it, and publishes them to all listeners
+	// when they change.
+	authorizationPublisher struct {
+		pod    PodID
+		port   Port
+		k8sAPI *k8s.API
+		log    *logging.Entry
+
+		authorizations []*authz.ServerAuthorization
+		// synced is set once the ServerAuthorizations selecting the port
+		// could be looked up. Listeners aren't updated before then, as
+		// publishing no authorizations would authorize all clients.
+		synced    bool
+		listeners []AuthorizationUpdateListener
+		// All access to the authorizationPublisher is explicitly synchronized
+		// by this mutex.
+		sync.Mutex
+	}
+
+	// AuthorizationUpdateListener is the interface that subscribers must
+	// implement. Update is called with the ServerAuthorizations selecting the
+	// port, sorted by name; none means that all clients are authorized. It's
+	// only called once the pod is known, so a port of a pod that isn't synced
+	// yet doesn't authorize all clients.
+	AuthorizationUpdateListener interface {
+		Update(authorizations []*authz.ServerAuthorization)
+	}
+)
+
+// NewServerAuthorizationWatcher creates a ServerAuthorizationWatcher and
+// begins watching the k8sAPI for ServerAuthorization and pod changes.
+func NewServerAuthorizationWatcher(k8sAPI *k8s.API, log *logging.Entry) *ServerAuthorizationWatcher {
+	aw := &ServerAuthorizationWatcher{
+		publishers: make(map[authorizationPublisherKey]*authorizationPublisher),
+		k8sAPI:     k8sAPI,
+		log:        log.WithField("component", "server-authorization-watcher"),
+	}
+
+	k8sAPI.AUTHZ().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    aw.updateAuthorization,
+		DeleteFunc: aw.updateAuthorization,
+		UpdateFunc: func(_, obj interface{}) { aw.updateAuthorization(obj) },
+	})
+
+	k8sAPI.Pod().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    aw.updatePod,
+		DeleteFunc: aw.updatePod,
+		UpdateFunc: func(_, obj interface{}) { aw.updatePod(obj) },
+	})
+
+	return aw
+}
+
+//////////////////////////////////
+/// ServerAuthorizationWatcher ///
+//////////////////////////////////
+
+// Subscribe to a port of a pod.
+// The provided listener will be updated each time the ServerAuthorizations
+// selecting the port change.
+func (aw *ServerAuthorizationWatcher) Subscribe(pod PodID, port Port, listener AuthorizationUpdateListener) {
+	aw.log.Debugf("Establishing watch on server authorizations of [%s:%d]", pod, port)
+
+	// The lock is held until the listener is subscribed, so that a concurrent
+	// Unsubscribe can't drop the publisher in between.
+	aw.Lock()
+	defer aw.Unlock()
+
+	aw.getOrNewPublisher(pod, port).subscribe(listener)
+}
+
+// Unsubscribe removes a listener from the subscribers list for this port of a
+// pod.
+func (aw *ServerAuthorizationWatcher) Unsubscribe(pod PodID, port Port, listener AuthorizationUpdateListener) {
+	aw.log.Debugf("Stopping watch on server authorizations of [%s:%d]", pod, port)
+
+	aw.Lock()
+	defer aw.Unlock()
+
+	key := authorizationPublisherKey{pod, port}
+	publisher, ok := aw.publishers[key]
+	if !ok {
+		aw.log.Errorf("Cannot unsubscribe from unknown pod port [%s:%d]", pod, port)
+		return
+	}
+	if publisher.unsubscribe(listener) == 0 {
+		delete(aw.publishers, key)
+	}
+}
+
+func (aw *ServerAuthorizationWatcher) updateAuthorization(obj interface{}) {
+	serverAuthz, ok := obj.(*authz.ServerAuthorization)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			aw.log.Errorf("couldn't get object from DeletedFinalStateUnknown %#v", obj)
+			return
+		}
+		serverAuthz, ok = tombstone.Obj.(*authz.ServerAuthorization)
+		if !ok {
+			aw.log.Errorf("DeletedFinalStateUnknown contained object that is not a ServerAuthorization %#v", obj)
+			return
+		}
+	}
+
+	ns := serverAuthz.Namespace
+	for _, publisher := range aw.getPublishers(func(key authorizationPublisherKey) bool { return key.pod.Namespace == ns }) {
+		publisher.refresh()
+	}
+}
+
+func (aw *ServerAuthorizationWatcher) updatePod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			aw.log.Errorf("couldn't get object from DeletedFinalStateUnknown %#v", obj)
+			return
+		}
+		pod, ok = tombstone.Obj.(*corev1.Pod)
+		if !ok {
+			aw.log.Errorf("DeletedFinalStateUnknown contained object that is not a Pod %#v", obj)
+			return
+		}
+	}
+
+	// The pod's labels may have changed, so it may have gained or lost
+	// ServerAuthorizations.
+	id := PodID{Namespace: pod.Namespace, Name: pod.Name}
+	for _, publisher := range aw.getPublishers(func(key authorizationPublisherKey) bool { return key.pod == id }) {
+		publisher.refresh()
+	}
+}
+
+// getOrNewPublisher must be called with the watcher's lock held.
+func (aw *ServerAuthorizationWatcher) getOrNewPublisher(pod PodID, port Port) *authorizationPublisher {
+	key := authorizationPublisherKey{pod, port}
+	publisher, ok := aw.publishers[key]
+	if !ok {
+		publisher = &authorizationPublisher{
+			pod:    pod,
+			port:   port,
+			k8sAPI: aw.k8sAPI,
+			log: aw.log.WithFields(logging.Fields{
+				"component": "server-authorization-publisher",
+				"ns":        pod.Namespace,
+				"pod":       pod.Name,
+				"port":      port,
+			}),
+		}
+		if authorizations, err := publisher.selectedAuthorizations(); err != nil {
+			publisher.log.Debugf("Holding server authorizations: %s", err)
+		} else {
+			publisher.authorizations = authorizations
+			publisher.synced = true
+		}
+		aw.publishers[key] = publisher
+	}
+	return publisher
+}
+
+func (aw *ServerAuthorizationWatcher) getPublishers(matches func(authorizationPublisherKey) bool) []*authorizationPublisher {
+	aw.RLock()
+	defer aw.RUnlock()
+
+	publishers := []*authorizationPublisher{}
+	for key, publisher := range aw.publishers {
+		if matches(key) {
+			publishers = append(publishers, publisher)
+		}
+	}
+	return publishers
+}
+
+//////////////////////////////
+/// authorizationPublisher ///
+//////////////////////////////
+
+func (ap *authorizationPublisher) subscribe(listener AuthorizationUpdateListener) {
+	ap.Lock()
+	defer ap.Unlock()
+
+	ap.listeners = append(ap.listeners, listener)
+	if ap.synced {
+		listener.Update(ap.authorizations)
+	}
+}
+
+// unsubscribe returns the number of listeners left.
+func (ap *authorizationPublisher) unsubscribe(listener AuthorizationUpdateListener) int {
+	ap.Lock()
+	defer ap.Unlock()
+
+	for i, e := range ap.listeners {
+		if e == listener {
+			n := len(ap.listeners)
+			ap.listeners[i] = ap.listeners[n-1]
+			ap.listeners[n-1] = nil
+			ap.listeners = ap.listeners[:n-1]
+			break
+		}
+	}
+	return len(ap.listeners)
+}
+
+func (ap *authorizationPublisher) refresh() {
+	ap.Lock()
+	defer ap.Unlock()
+
+	authorizations, err := ap.selectedAuthorizations()
+	if err != nil {
+		// Keep the last published authorizations rather than authorizing
+		// all clients.
+		ap.log.Debugf("Holding server authorizations: %s", err)
+		return
+	}
+	if ap.synced && authorizationsEqual(ap.authorizations, authorizations) {
+		return
+	}
+	ap.authorizations = authorizations
+	ap.synced = true
+	for _, listener := range ap.listeners {
+		listener.Update(authorizations)
+	}
+}
+
+// selectedAuthorizations returns the ServerAuthorizations of the pod's
+// namespace that select the port, sorted by name. An error is returned if the
+// pod isn't known, as the authorizations selecting it can't be told apart.
+func (ap *authorizationPublisher) selectedAuthorizations() ([]*authz.ServerAuthorization, error) {
+	pod, err := ap.k8sAPI.Pod().Lister().Pods(ap.pod.Namespace).Get(ap.pod.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			ap.log.Errorf("error getting pod: %s", err)
+		}
+		return nil, fmt.Errorf("error getting pod: %s", err)
+	}
+
+	serverAuthzs, err := ap.k8sAPI.AUTHZ().Lister().ServerAuthorizations(ap.pod.Namespace).List(labels.Everything())
+	if err != nil {
+		ap.log.Errorf("error listing server authorizations: %s", err)
+		return nil, fmt.Errorf("error listing server authorizations: %s", err)
+	}
+
+	selected := []*authz.ServerAuthorization{}
+	for _, serverAuthz := range serverAuthzs {
+		if policy.SelectsPort(serverAuthz, pod, uint32(ap.port)) {
+			selected = append(selected, serverAuthz)
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool { return selected[i].Name < selected[j].Name })
+	return selected, nil
+}
+
+func authorizationsEqual(a, b []*authz.ServerAuthorization) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].ResourceVersion != b[i].ResourceVersion {
+			return false
+		}
+	}
+	return true
+}
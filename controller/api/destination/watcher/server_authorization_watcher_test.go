@@ -0,0 +1,225 @@
+package watcher
+
+import (
+	"reflect"
+	"testing"
+
+	authz "github.com/linkerd/linkerd2/controller/gen/apis/policy/v1alpha1"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	logging "github.com/sirupsen/logrus"
+)
+
+const testAuthzPod = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-1
+  namespace: ns
+  labels:
+    app: web
+spec:
+  containers:
+  - name: web
+    ports:
+    - name: http
+      containerPort: 8080
+    - name: admin-http
+      containerPort: 9990`
+
+const testAuthz = `
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: web-http
+  namespace: ns
+  resourceVersion: "1"
+spec:
+  podSelector:
+    matchLabels:
+      app: web
+  ports:
+  - http
+  client:
+    meshTLS:
+      namespaces:
+      - ns`
+
+type bufferingAuthorizationListener struct {
+	updates [][]string
+}
+
+func (bal *bufferingAuthorizationListener) Update(authorizations []*authz.ServerAuthorization) {
+	names := []string{}
+	for _, serverAuthz := range authorizations {
+		names = append(names, serverAuthz.Name)
+	}
+	bal.updates = append(bal.updates, names)
+}
+
+func TestServerAuthorizationWatcher(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		k8sConfigs     []string
+		port           Port
+		expectedAuthzs []string
+	}{
+		{
+			name:           "port selected by name",
+			k8sConfigs:     []string{testAuthzPod, testAuthz},
+			port:           8080,
+			expectedAuthzs: []string{"web-http"},
+		},
+		{
+			name:           "port not selected",
+			k8sConfigs:     []string{testAuthzPod, testAuthz},
+			port:           9990,
+			expectedAuthzs: []string{},
+		},
+		{
+			name: "all ports selected",
+			k8sConfigs: []string{testAuthzPod, testAuthz, `
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: all
+  namespace: ns
+spec:
+  podSelector: {}
+  client:
+    unauthenticated: true`},
+			port:           8080,
+			expectedAuthzs: []string{"all", "web-http"},
+		},
+		{
+			name: "authorization in another namespace",
+			k8sConfigs: []string{testAuthzPod, `
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: all
+  namespace: other
+spec:
+  podSelector: {}
+  client:
+    unauthenticated: true`},
+			port:           8080,
+			expectedAuthzs: []string{},
+		},
+	} {
+		tt := tt // pin
+		t.Run(tt.name, func(t *testing.T) {
+			k8sAPI, err := k8s.NewFakeAPI(tt.k8sConfigs...)
+			if err != nil {
+				t.Fatalf("NewFakeAPI returned an error: %s", err)
+			}
+
+			watcher := NewServerAuthorizationWatcher(k8sAPI, logging.WithField("test", t.Name()))
+
+			k8sAPI.Sync(nil)
+
+			listener := &bufferingAuthorizationListener{}
+			watcher.Subscribe(PodID{Namespace: "ns", Name: "web-1"}, tt.port, listener)
+
+			expected := [][]string{tt.expectedAuthzs}
+			if !reflect.DeepEqual(listener.updates, expected) {
+				t.Fatalf("Expected updates %v, got %v", expected, listener.updates)
+			}
+		})
+	}
+}
+
+func TestServerAuthorizationWatcherPodNotSynced(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(testAuthz)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+
+	watcher := NewServerAuthorizationWatcher(k8sAPI, logging.WithField("test", t.Name()))
+
+	k8sAPI.Sync(nil)
+
+	listener := &bufferingAuthorizationListener{}
+	watcher.Subscribe(PodID{Namespace: "ns", Name: "web-1"}, 8080, listener)
+
+	// Publishing no authorizations would authorize all clients, so nothing
+	// is published until the pod is known.
+	if len(listener.updates) != 0 {
+		t.Fatalf("Expected no updates before the pod is synced, got %v", listener.updates)
+	}
+
+	pod, err := pkgK8s.ToRuntimeObject(testAuthzPod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := k8sAPI.Pod().Informer().GetStore().Add(pod); err != nil {
+		t.Fatal(err)
+	}
+	watcher.updatePod(pod)
+
+	// Deleting the pod holds the last published authorizations.
+	if err := k8sAPI.Pod().Informer().GetStore().Delete(pod); err != nil {
+		t.Fatal(err)
+	}
+	watcher.updatePod(pod)
+
+	expected := [][]string{{"web-http"}}
+	if !reflect.DeepEqual(listener.updates, expected) {
+		t.Fatalf("Expected updates %v, got %v", expected, listener.updates)
+	}
+}
+
+func TestServerAuthorizationWatcherUpdates(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(testAuthzPod, testAuthz)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+
+	watcher := NewServerAuthorizationWatcher(k8sAPI, logging.WithField("test", t.Name()))
+
+	k8sAPI.Sync(nil)
+
+	id := PodID{Namespace: "ns", Name: "web-1"}
+	listener := &bufferingAuthorizationListener{}
+	watcher.Subscribe(id, 8080, listener)
+
+	serverAuthz, err := k8sAPI.AUTHZ().Lister().ServerAuthorizations("ns").Get("web-http")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Refreshing without changes doesn't publish anything.
+	watcher.updateAuthorization(serverAuthz)
+
+	// Changing the authorization publishes it again.
+	updated := serverAuthz.DeepCopy()
+	updated.ResourceVersion = "2"
+	updated.Spec.Client.Unauthenticated = true
+	if err := k8sAPI.AUTHZ().Informer().GetStore().Update(updated); err != nil {
+		t.Fatal(err)
+	}
+	watcher.updateAuthorization(updated)
+
+	// Relabelling the pod drops the authorization.
+	pod, err := k8sAPI.Pod().Lister().Pods("ns").Get("web-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	relabelled := pod.DeepCopy()
+	relabelled.Labels = map[string]string{"app": "other"}
+	if err := k8sAPI.Pod().Informer().GetStore().Update(relabelled); err != nil {
+		t.Fatal(err)
+	}
+	watcher.updatePod(relabelled)
+
+	expected := [][]string{{"web-http"}, {"web-http"}, {}}
+	if !reflect.DeepEqual(listener.updates, expected) {
+		t.Fatalf("Expected updates %v, got %v", expected, listener.updates)
+	}
+
+	// Unsubscribing the last listener drops the publisher.
+	watcher.Unsubscribe(id, 8080, listener)
+	if len(watcher.publishers) != 0 {
+		t.Fatalf("Expected no publishers, got %d", len(watcher.publishers))
+	}
+}
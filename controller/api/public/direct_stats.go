@@ -0,0 +1,497 @@
+package public
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	responseTotalMetric     = "response_total"
+	responseLatencyMetric   = "response_latency_ms"
+	tcpConnectionsMetric    = "tcp_open_connections"
+	tcpReadBytesMetric      = "tcp_read_bytes_total"
+	tcpWriteBytesMetric     = "tcp_write_bytes_total"
+	directScrapeTimeout     = 10 * time.Second
+	maxDirectTimeWindow     = time.Minute
+	maxDirectScrapePods     = 500
+	maxDirectScrapeWorkers  = 20
+	proxyJobPodLabel        = "linkerd_io_proxy_job"
+	proxyPodLabelPrefix     = "linkerd_io_proxy_"
+	linkerdPodLabelPrefix   = "linkerd_io_"
+	classificationLabelName = model.LabelName("classification")
+	tlsLabelName            = model.LabelName("tls")
+)
+
+var (
+	directMetrics = map[string]bool{
+		responseTotalMetric:   true,
+		responseLatencyMetric: true,
+		tcpConnectionsMetric:  true,
+		tcpReadBytesMetric:    true,
+		tcpWriteBytesMetric:   true,
+	}
+
+	invalidLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+	directScraperClient = &http.Client{Timeout: directScrapeTimeout}
+)
+
+// proxyScraper returns the metrics exposed by the proxy of a meshed pod, in
+// the Prometheus text format.
+type proxyScraper func(ctx context.Context, pod *corev1.Pod) ([]byte, error)
+
+// directSeries is a series scraped from a proxy, labeled the way Prometheus
+// labels the series of the linkerd-proxy job. value holds the increase over
+// the time window for counters and histogram buckets, and the last value for
+// gauges.
+type directSeries struct {
+	metric string
+	labels model.LabelSet
+	le     float64
+	value  float64
+}
+
+// directMatcher is a label matcher of a Prometheus query.
+type directMatcher struct {
+	name  model.LabelName
+	value string
+	re    *regexp.Regexp
+}
+
+func (m directMatcher) matches(ls model.LabelSet) bool {
+	if m.re != nil {
+		return m.re.MatchString(string(ls[m.name]))
+	}
+	return string(ls[m.name]) == m.value
+}
+
+type directScrapesKey struct{}
+
+// directScrapes memoizes the proxy scrapes of a StatSummary request, so that
+// the queries it fans out to, one per resource type and traffic split, share
+// them rather than each waiting for a time window.
+type directScrapes struct {
+	sync.Mutex
+	byNamespace map[string]*directScrape
+}
+
+type directScrape struct {
+	once   sync.Once
+	series []directSeries
+	err    error
+}
+
+func withDirectScrapes(ctx context.Context) context.Context {
+	return context.WithValue(ctx, directScrapesKey{}, &directScrapes{byNamespace: map[string]*directScrape{}})
+}
+
+// getDirectMetrics evaluates the stat queries of the given types against the
+// proxies of the meshed pods, instead of Prometheus. The pods are scraped at
+// the start and at the end of the time window, so the call blocks for the
+// duration of the window.
+func (s *grpcServer) getDirectMetrics(ctx context.Context, promTypes []promType, matchers []directMatcher, timeWindow string, groupBy model.LabelNames) ([]promResult, error) {
+	window, err := directTimeWindow(timeWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the pods that can match the query are scraped; queries without a
+	// namespace, such as traffic split queries, scrape all the meshed pods.
+	namespace := ""
+	for _, m := range matchers {
+		if m.name == namespaceLabel && m.re == nil {
+			namespace = m.value
+		}
+	}
+
+	scrapes, ok := ctx.Value(directScrapesKey{}).(*directScrapes)
+	if !ok {
+		scrapes = &directScrapes{byNamespace: map[string]*directScrape{}}
+	}
+	scrapes.Lock()
+	scrape, ok := scrapes.byNamespace[namespace]
+	if !ok {
+		scrape = &directScrape{}
+		scrapes.byNamespace[namespace] = scrape
+	}
+	scrapes.Unlock()
+
+	scrape.once.Do(func() {
+		scrape.series, scrape.err = s.scrapeProxies(ctx, namespace, window)
+	})
+	if scrape.err != nil {
+		return nil, scrape.err
+	}
+
+	return evalDirectMetrics(scrape.series, promTypes, matchers, groupBy), nil
+}
+
+// directTimeWindow parses the time window of a direct stat query. The window
+// is capped, as the query blocks for its whole duration.
+func directTimeWindow(timeWindow string) (time.Duration, error) {
+	window, err := model.ParseDuration(timeWindow)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time window %q: %s", timeWindow, err)
+	}
+	if time.Duration(window) > maxDirectTimeWindow {
+		return 0, fmt.Errorf("the time window of direct stats must be at most %s, got %s", maxDirectTimeWindow, timeWindow)
+	}
+	return time.Duration(window), nil
+}
+
+// scrapeProxies scrapes the proxies of the meshed pods of namespace, or of all
+// namespaces if it is empty, twice, window apart, and returns their series.
+func (s *grpcServer) scrapeProxies(ctx context.Context, namespace string, window time.Duration) ([]directSeries, error) {
+	selector := labels.Set{k8s.ControllerNSLabel: s.controllerNamespace}.AsSelector()
+	pods, err := s.k8sAPI.Pod().Lister().Pods(namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	running := []*corev1.Pod{}
+	for _, pod := range pods {
+		if pod.Status.Phase == corev1.PodRunning && pod.Status.PodIP != "" {
+			running = append(running, pod)
+		}
+	}
+	if len(running) > maxDirectScrapePods {
+		return nil, fmt.Errorf("too many meshed pods to scrape directly (%d, at most %d); select a namespace or query Prometheus instead", len(running), maxDirectScrapePods)
+	}
+
+	start := time.Now()
+	first := s.scrapePods(ctx, running)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-time.After(window - time.Since(start)):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	last := s.scrapePods(ctx, running)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	series := []directSeries{}
+	for i, pod := range running {
+		if first[i] == nil || last[i] == nil {
+			continue
+		}
+		podLabels := promPodLabels(pod)
+		for key, end := range last[i] {
+			if start, ok := first[i][key]; ok && end.metric != tcpConnectionsMetric {
+				// Counters only decrease when the proxy restarts.
+				if end.value >= start.value {
+					end.value -= start.value
+				}
+			}
+			end.labels = end.labels.Merge(podLabels)
+			series = append(series, end)
+		}
+	}
+	return series, nil
+}
+
+// scrapePods scrapes the pods concurrently, at most maxDirectScrapeWorkers at
+// once. The series of pods that could not be scraped are nil, as these pods
+// are left out of the results, as Prometheus would. Pods are no longer
+// scraped once ctx is done.
+func (s *grpcServer) scrapePods(ctx context.Context, pods []*corev1.Pod) []map[string]directSeries {
+	results := make([]map[string]directSeries, len(pods))
+	sem := make(chan struct{}, maxDirectScrapeWorkers)
+	var wg sync.WaitGroup
+	for i, pod := range pods {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int, pod *corev1.Pod) {
+			defer func() { <-sem; wg.Done() }()
+			metrics, err := s.scrapeProxy(ctx, pod)
+			if err != nil {
+				log.Warnf("failed to scrape the proxy of pod %s/%s: %s", pod.Namespace, pod.Name, err)
+				return
+			}
+			series, err := parseDirectSeries(metrics)
+			if err != nil {
+				log.Warnf("failed to parse the metrics of pod %s/%s: %s", pod.Namespace, pod.Name, err)
+				return
+			}
+			results[i] = series
+		}(i, pod)
+	}
+	wg.Wait()
+	return results
+}
+
+// scrapeProxyMetrics fetches the metrics of the proxy of pod, on its admin
+// port.
+func scrapeProxyMetrics(ctx context.Context, pod *corev1.Pod) ([]byte, error) {
+	port := int32(0)
+	for _, container := range pod.Spec.Containers {
+		if container.Name != k8s.ProxyContainerName {
+			continue
+		}
+		for _, p := range container.Ports {
+			if p.Name == k8s.ProxyAdminPortName {
+				port = p.ContainerPort
+			}
+		}
+	}
+	if port == 0 {
+		return nil, fmt.Errorf("no %s port found", k8s.ProxyAdminPortName)
+	}
+
+	url := fmt.Sprintf("http://%s:%d/metrics", pod.Status.PodIP, port)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := directScraperClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s from %s", rsp.Status, url)
+	}
+	return ioutil.ReadAll(rsp.Body)
+}
+
+// parseDirectSeries parses the stat metrics of a proxy scrape, keyed by
+// series.
+func parseDirectSeries(metrics []byte) (map[string]directSeries, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(metrics))
+	if err != nil {
+		return nil, err
+	}
+
+	series := map[string]directSeries{}
+	add := func(s directSeries) {
+		key := fmt.Sprintf("%s%s%g", s.metric, s.labels, s.le)
+		series[key] = s
+	}
+	for name, family := range families {
+		if !directMetrics[name] {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			ls := model.LabelSet{}
+			for _, l := range m.GetLabel() {
+				ls[model.LabelName(l.GetName())] = model.LabelValue(l.GetValue())
+			}
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				add(directSeries{metric: name, labels: ls, value: m.GetCounter().GetValue()})
+			case dto.MetricType_GAUGE:
+				add(directSeries{metric: name, labels: ls, value: m.GetGauge().GetValue()})
+			case dto.MetricType_HISTOGRAM:
+				for _, b := range m.GetHistogram().GetBucket() {
+					add(directSeries{metric: name, labels: ls, le: b.GetUpperBound(), value: float64(b.GetCumulativeCount())})
+				}
+				add(directSeries{metric: name, labels: ls, le: math.Inf(1), value: float64(m.GetHistogram().GetSampleCount())})
+			}
+		}
+	}
+	return series, nil
+}
+
+// promPodLabels returns the target labels the linkerd-proxy job of the
+// Prometheus add-on attaches to the series scraped from pod.
+func promPodLabels(pod *corev1.Pod) model.LabelSet {
+	ls := model.LabelSet{}
+	for key, value := range pod.Labels {
+		name := invalidLabelChars.ReplaceAllString(key, "_")
+		switch {
+		case name == proxyJobPodLabel:
+			name = "k8s_job"
+		case strings.HasPrefix(name, proxyPodLabelPrefix):
+			name = strings.TrimPrefix(name, proxyPodLabelPrefix)
+		case strings.HasPrefix(name, linkerdPodLabelPrefix):
+			name = strings.TrimPrefix(name, linkerdPodLabelPrefix)
+		}
+		ls[model.LabelName(name)] = model.LabelValue(value)
+	}
+	ls[namespaceLabel] = model.LabelValue(pod.Namespace)
+	ls[model.LabelName("pod")] = model.LabelValue(pod.Name)
+	return ls
+}
+
+// promTypes returns the types of the queries.
+func promTypes(queries map[promType]string) []promType {
+	types := []promType{}
+	for pt := range queries {
+		types = append(types, pt)
+	}
+	return types
+}
+
+// directMatchers returns the matchers of a query for the labels and, if
+// regexLabel is not empty, a regex match of it like the one
+// generateLabelStringWithRegex inserts.
+func directMatchers(ls model.LabelSet, regexLabel, stringToMatch string) []directMatcher {
+	matchers := []directMatcher{}
+	for name, value := range ls {
+		matchers = append(matchers, directMatcher{name: name, value: string(value)})
+	}
+	if regexLabel != "" {
+		matchers = append(matchers, directMatcher{
+			name: model.LabelName(regexLabel),
+			re:   regexp.MustCompile(fmt.Sprintf("^%s.+$", stringToMatch)),
+		})
+	}
+	return matchers
+}
+
+// evalDirectMetrics evaluates the stat queries of the given types, and the
+// latency quantiles, against the scraped series.
+func evalDirectMetrics(series []directSeries, promTypes []promType, matchers []directMatcher, groupBy model.LabelNames) []promResult {
+	results := []promResult{}
+	for _, pt := range promTypes {
+		switch pt {
+		case promRequests:
+			by := append(model.LabelNames{classificationLabelName, tlsLabelName}, groupBy...)
+			results = append(results, promResult{prom: pt, vec: sumDirectSeries(series, responseTotalMetric, matchers, by)})
+		case promTCPConnections:
+			results = append(results, promResult{prom: pt, vec: sumDirectSeries(series, tcpConnectionsMetric, matchers, groupBy)})
+		case promTCPReadBytes:
+			results = append(results, promResult{prom: pt, vec: sumDirectSeries(series, tcpReadBytesMetric, matchers, groupBy)})
+		case promTCPWriteBytes:
+			results = append(results, promResult{prom: pt, vec: sumDirectSeries(series, tcpWriteBytesMetric, matchers, groupBy)})
+		}
+	}
+
+	buckets := map[model.Fingerprint]*latencyBuckets{}
+	for _, s := range series {
+		if s.metric != responseLatencyMetric || !matchAll(s.labels, matchers) {
+			continue
+		}
+		metric := groupLabels(s.labels, groupBy)
+		fp := metric.Fingerprint()
+		if buckets[fp] == nil {
+			buckets[fp] = &latencyBuckets{metric: metric, counts: map[float64]float64{}}
+		}
+		buckets[fp].counts[s.le] += s.value
+	}
+	for _, quantile := range []promType{promLatencyP50, promLatencyP95, promLatencyP99} {
+		q, _ := strconv.ParseFloat(string(quantile), 64)
+		vec := model.Vector{}
+		for _, b := range buckets {
+			vec = append(vec, &model.Sample{Metric: b.metric, Value: model.SampleValue(b.quantile(q))})
+		}
+		results = append(results, promResult{prom: quantile, vec: vec})
+	}
+	return results
+}
+
+// sumDirectSeries sums the series of metric matching all the matchers,
+// grouped by the given labels.
+func sumDirectSeries(series []directSeries, metric string, matchers []directMatcher, by model.LabelNames) model.Vector {
+	sums := map[model.Fingerprint]*model.Sample{}
+	for _, s := range series {
+		if s.metric != metric || !matchAll(s.labels, matchers) {
+			continue
+		}
+		m := groupLabels(s.labels, by)
+		fp := m.Fingerprint()
+		if sums[fp] == nil {
+			sums[fp] = &model.Sample{Metric: m}
+		}
+		sums[fp].Value += model.SampleValue(s.value)
+	}
+
+	vec := model.Vector{}
+	for _, sample := range sums {
+		vec = append(vec, sample)
+	}
+	return vec
+}
+
+func matchAll(ls model.LabelSet, matchers []directMatcher) bool {
+	for _, m := range matchers {
+		if !m.matches(ls) {
+			return false
+		}
+	}
+	return true
+}
+
+// groupLabels returns the labels of ls that are in by, as an aggregation with
+// `by` would.
+func groupLabels(ls model.LabelSet, by model.LabelNames) model.Metric {
+	m := model.Metric{}
+	for _, name := range by {
+		if value, ok := ls[name]; ok && value != "" {
+			m[name] = value
+		}
+	}
+	return m
+}
+
+// latencyBuckets holds the cumulative counts of the latency histogram buckets
+// of a group, by upper bound.
+type latencyBuckets struct {
+	metric model.Metric
+	counts map[float64]float64
+}
+
+// quantile estimates the q-quantile of the histogram the way Prometheus'
+// histogram_quantile does, interpolating linearly within the bucket the
+// quantile falls in.
+func (b *latencyBuckets) quantile(q float64) float64 {
+	bounds := make([]float64, 0, len(b.counts))
+	for bound := range b.counts {
+		bounds = append(bounds, bound)
+	}
+	sort.Float64s(bounds)
+	if len(bounds) < 2 || !math.IsInf(bounds[len(bounds)-1], 1) {
+		return math.NaN()
+	}
+
+	total := b.counts[bounds[len(bounds)-1]]
+	if total == 0 {
+		return math.NaN()
+	}
+	rank := q * total
+
+	i := sort.Search(len(bounds)-1, func(i int) bool { return b.counts[bounds[i]] >= rank })
+	if i == len(bounds)-1 {
+		return bounds[len(bounds)-2]
+	}
+	if i == 0 && bounds[0] <= 0 {
+		return bounds[0]
+	}
+
+	start, count := 0.0, b.counts[bounds[i]]
+	if i > 0 {
+		start = bounds[i-1]
+		count -= b.counts[bounds[i-1]]
+		rank -= b.counts[bounds[i-1]]
+	}
+	return start + (bounds[i]-start)*(rank/count)
+}
@@ -0,0 +1,269 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const directFirstScrape = `# TYPE response_total counter
+response_total{direction="inbound",classification="success",tls="true"} 10
+response_total{direction="inbound",classification="failure",tls="true"} 2
+response_total{direction="outbound",classification="success",tls="true"} 100
+# TYPE response_latency_ms histogram
+response_latency_ms_bucket{direction="inbound",le="10"} 5
+response_latency_ms_bucket{direction="inbound",le="100"} 10
+response_latency_ms_bucket{direction="inbound",le="+Inf"} 12
+response_latency_ms_sum{direction="inbound"} 300
+response_latency_ms_count{direction="inbound"} 12
+# TYPE tcp_open_connections gauge
+tcp_open_connections{direction="inbound"} 3
+# TYPE tcp_read_bytes_total counter
+tcp_read_bytes_total{direction="inbound"} 1000
+`
+
+const directLastScrape = `# TYPE response_total counter
+response_total{direction="inbound",classification="success",tls="true"} 40
+response_total{direction="inbound",classification="failure",tls="true"} 4
+response_total{direction="outbound",classification="success",tls="true"} 200
+# TYPE response_latency_ms histogram
+response_latency_ms_bucket{direction="inbound",le="10"} 25
+response_latency_ms_bucket{direction="inbound",le="100"} 40
+response_latency_ms_bucket{direction="inbound",le="+Inf"} 44
+response_latency_ms_sum{direction="inbound"} 1200
+response_latency_ms_count{direction="inbound"} 44
+# TYPE tcp_open_connections gauge
+tcp_open_connections{direction="inbound"} 2
+# TYPE tcp_read_bytes_total counter
+tcp_read_bytes_total{direction="inbound"} 500
+`
+
+func TestLatencyBucketsQuantile(t *testing.T) {
+	testCases := []struct {
+		name     string
+		counts   map[float64]float64
+		q        float64
+		expected float64
+	}{
+		{"first bucket", map[float64]float64{10: 20, 100: 30, math.Inf(1): 32}, 0.5, 8},
+		{"interpolated", map[float64]float64{10: 20, 100: 30, math.Inf(1): 32}, 0.75, 46},
+		{"last bucket", map[float64]float64{10: 20, 100: 30, math.Inf(1): 32}, 0.95, 100},
+		{"no requests", map[float64]float64{10: 0, math.Inf(1): 0}, 0.5, math.NaN()},
+		{"no +Inf bucket", map[float64]float64{10: 20, 100: 30}, 0.5, math.NaN()},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			b := &latencyBuckets{counts: tc.counts}
+			actual := b.quantile(tc.q)
+			if math.IsNaN(tc.expected) {
+				if !math.IsNaN(actual) {
+					t.Fatalf("Expected NaN, got %f", actual)
+				}
+				return
+			}
+			if actual != tc.expected {
+				t.Fatalf("Expected %f, got %f", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestStatSummaryDirect(t *testing.T) {
+	mockProm, fakeGrpcServer, err := newMockGrpcServer(expectedStatRPC{
+		k8sConfigs: []string{`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: emoji
+  namespace: emojivoto
+  uid: a1b2c3
+spec:
+  selector:
+    matchLabels:
+      app: emoji-svc
+  template:
+    spec:
+      containers:
+      - image: buoyantio/emojivoto-emoji-svc:v10
+`, `
+apiVersion: apps/v1
+kind: ReplicaSet
+metadata:
+  uid: a1b2c3d4
+  name: emoji-3c2b1a
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    pod-template-hash: 3c2b1a
+  ownerReferences:
+  - apiVersion: apps/v1
+    uid: a1b2c3
+spec:
+  selector:
+    matchLabels:
+      app: emoji-svc
+      pod-template-hash: 3c2b1a
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emoji-3c2b1a-x7k2p
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: linkerd
+    linkerd.io/proxy-deployment: emoji
+    pod-template-hash: 3c2b1a
+  ownerReferences:
+  - apiVersion: apps/v1
+    uid: a1b2c3d4
+status:
+  phase: Running
+  podIP: 10.1.1.1
+`,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error creating mock grpc server: %s", err)
+	}
+
+	var mu sync.Mutex
+	scrapes := []string{directFirstScrape, directLastScrape}
+	fakeGrpcServer.scrapeProxy = func(ctx context.Context, pod *corev1.Pod) ([]byte, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(scrapes) == 0 {
+			return nil, fmt.Errorf("unexpected scrape of pod %s", pod.Name)
+		}
+		metrics := scrapes[0]
+		scrapes = scrapes[1:]
+		return []byte(metrics), nil
+	}
+
+	rsp, err := fakeGrpcServer.StatSummary(context.Background(), &pb.StatSummaryRequest{
+		Selector: &pb.ResourceSelection{
+			Resource: &pb.Resource{
+				Namespace: "emojivoto",
+				Type:      pkgK8s.Deployment,
+			},
+		},
+		TimeWindow: "10ms",
+		TcpStats:   true,
+		Direct:     true,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(mockProm.QueriesExecuted) != 0 {
+		t.Fatalf("Expected no Prometheus queries, got %v", mockProm.QueriesExecuted)
+	}
+
+	rows := rsp.GetOk().GetStatTables()[0].GetPodGroup().GetRows()
+	if len(rows) != 1 {
+		t.Fatalf("Expected 1 row, got %d", len(rows))
+	}
+	expectedStats := &pb.BasicStats{
+		SuccessCount: 30,
+		FailureCount: 2,
+		LatencyMsP50: 8,
+		LatencyMsP95: 100,
+		LatencyMsP99: 100,
+	}
+	if !proto.Equal(rows[0].Stats, expectedStats) {
+		t.Fatalf("Expected stats %v, got %v", expectedStats, rows[0].Stats)
+	}
+	expectedTCPStats := &pb.TcpStats{
+		OpenConnections: 2,
+		ReadBytesTotal:  500,
+	}
+	if !proto.Equal(rows[0].TcpStats, expectedTCPStats) {
+		t.Fatalf("Expected TCP stats %v, got %v", expectedTCPStats, rows[0].TcpStats)
+	}
+}
+
+func TestStatSummaryDirectTimeWindow(t *testing.T) {
+	_, fakeGrpcServer, err := newMockGrpcServer(expectedStatRPC{})
+	if err != nil {
+		t.Fatalf("Error creating mock grpc server: %s", err)
+	}
+
+	rsp, err := fakeGrpcServer.StatSummary(context.Background(), &pb.StatSummaryRequest{
+		Selector: &pb.ResourceSelection{
+			Resource: &pb.Resource{
+				Namespace: "emojivoto",
+				Type:      pkgK8s.Deployment,
+			},
+		},
+		TimeWindow: "2m",
+		Direct:     true,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := "the time window of direct stats must be at most 1m0s, got 2m"
+	if rsp.GetError().GetError() != expected {
+		t.Fatalf("Expected error %q, got %v", expected, rsp)
+	}
+}
+
+func TestScrapePods(t *testing.T) {
+	pods := []*corev1.Pod{}
+	for i := 0; i < 3*maxDirectScrapeWorkers; i++ {
+		pods = append(pods, &corev1.Pod{})
+	}
+
+	t.Run("bounded concurrency", func(t *testing.T) {
+		var mu sync.Mutex
+		inFlight, maxInFlight := 0, 0
+		s := &grpcServer{scrapeProxy: func(ctx context.Context, pod *corev1.Pod) ([]byte, error) {
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+			time.Sleep(time.Millisecond)
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			return []byte(directFirstScrape), nil
+		}}
+
+		results := s.scrapePods(context.Background(), pods)
+		for i, series := range results {
+			if series == nil {
+				t.Fatalf("Expected pod %d to be scraped", i)
+			}
+		}
+		if maxInFlight > maxDirectScrapeWorkers {
+			t.Fatalf("Expected at most %d concurrent scrapes, got %d", maxDirectScrapeWorkers, maxInFlight)
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		s := &grpcServer{scrapeProxy: func(ctx context.Context, pod *corev1.Pod) ([]byte, error) {
+			t.Error("Unexpected scrape")
+			return nil, ctx.Err()
+		}}
+
+		for i, series := range s.scrapePods(ctx, pods) {
+			if series != nil {
+				t.Fatalf("Expected pod %d not to be scraped", i)
+			}
+		}
+	})
+}
@@ -15,6 +15,10 @@ import (
 const (
 	inboundIdentityQuery  = "count(response_total%s) by (%s, client_id, namespace, no_tls_reason)"
 	outboundIdentityQuery = "count(response_total%s) by (%s, dst_%s, server_id, namespace, dst_namespace, no_tls_reason)"
+
+	// The edges seen over a time range, rather than at an instant.
+	inboundIdentityRangeQuery  = "count(count_over_time(response_total%s[%s])) by (%s, client_id, namespace, no_tls_reason)"
+	outboundIdentityRangeQuery = "count(count_over_time(response_total%s[%s])) by (%s, dst_%s, server_id, namespace, dst_namespace, no_tls_reason)"
 )
 
 var formatMsg = map[string]string{
@@ -64,8 +68,8 @@ func (s *grpcServer) getEdges(ctx context.Context, req *pb.EdgesRequest) ([]*pb.
 	}
 	selectedNamespace := req.Selector.Resource.Namespace
 	resourceType := string(labelNames[1]) // skipping first name which is always namespace
-	labelsOutbound := promDirectionLabels("outbound")
-	labelsInbound := promDirectionLabels("inbound")
+	labelsOutbound := s.withQueryLabels(promDirectionLabels("outbound"))
+	labelsInbound := s.withQueryLabels(promDirectionLabels("inbound"))
 
 	// checking that data for the specified resource type exists
 	labelsOutboundStr := generateLabelStringWithExclusion(labelsOutbound, resourceType)
@@ -74,12 +78,23 @@ func (s *grpcServer) getEdges(ctx context.Context, req *pb.EdgesRequest) ([]*pb.
 	outboundQuery := fmt.Sprintf(outboundIdentityQuery, labelsOutboundStr, resourceType, resourceType)
 	inboundQuery := fmt.Sprintf(inboundIdentityQuery, labelsInboundStr, resourceType)
 
-	inboundResult, err := s.queryProm(ctx, inboundQuery)
+	// The time window is only set for requests with a start time.
+	timeWindow, err := queryTimeWindow(req.GetStartTime(), req.GetEndTime(), "")
+	if err != nil {
+		return nil, err
+	}
+	if timeWindow != "" {
+		outboundQuery = fmt.Sprintf(outboundIdentityRangeQuery, labelsOutboundStr, timeWindow, resourceType, resourceType)
+		inboundQuery = fmt.Sprintf(inboundIdentityRangeQuery, labelsInboundStr, timeWindow, resourceType)
+	}
+	at := queryTime(req.GetEndTime())
+
+	inboundResult, err := s.queryProm(ctx, inboundQuery, at)
 	if err != nil {
 		return nil, err
 	}
 
-	outboundResult, err := s.queryProm(ctx, outboundQuery)
+	outboundResult, err := s.queryProm(ctx, outboundQuery, at)
 	if err != nil {
 		return nil, err
 	}
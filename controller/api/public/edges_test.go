@@ -3,8 +3,10 @@ package public
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/prometheus/common/model"
@@ -166,4 +168,43 @@ func TestEdges(t *testing.T) {
 
 		testEdges(t, expectations)
 	})
+
+	t.Run("Queries the edges seen over the requested time range", func(t *testing.T) {
+		exp := expectedStatRPC{
+			mockPromResponse: model.Vector{},
+			expectedPrometheusQueries: []string{
+				`count(count_over_time(response_total{deployment!="", direction="inbound"}[10m])) by (deployment, client_id, namespace, no_tls_reason)`,
+				`count(count_over_time(response_total{deployment!="", direction="outbound"}[10m])) by (deployment, dst_deployment, server_id, namespace, dst_namespace, no_tls_reason)`,
+			},
+		}
+		mockProm, fakeGrpcServer, err := newMockGrpcServer(exp)
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		end := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+		startTime, _ := ptypes.TimestampProto(end.Add(-10 * time.Minute))
+		endTime, _ := ptypes.TimestampProto(end)
+		_, err = fakeGrpcServer.Edges(context.TODO(), &pb.EdgesRequest{
+			Selector: &pb.ResourceSelection{
+				Resource: &pb.Resource{
+					Namespace: "emojivoto",
+					Type:      pkgK8s.Deployment,
+				},
+			},
+			StartTime: startTime,
+			EndTime:   endTime,
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if err := exp.verifyPromQueries(mockProm); err != nil {
+			t.Fatal(err)
+		}
+		for _, ts := range mockProm.QueryTimes {
+			if !ts.Equal(end) {
+				t.Fatalf("Expected queries to be evaluated at %s, got %s", end, ts)
+			}
+		}
+	})
 }
@@ -3,6 +3,7 @@ package public
 import (
 	"context"
 	"fmt"
+	"time"
 
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/k8s"
@@ -120,7 +121,7 @@ func (s *grpcServer) getGatewaysMetrics(ctx context.Context, req *pb.GatewaysReq
 		promGatewayAlive: gatewayAliveQuery,
 	}
 
-	metricsResp, err := s.getPrometheusMetrics(ctx, promQueries, gatewayLatencyQuantileQuery, labels.String(), timeWindow, groupBy.String())
+	metricsResp, err := s.getPrometheusMetrics(ctx, promQueries, gatewayLatencyQuantileQuery, s.withQueryLabels(labels).String(), timeWindow, groupBy.String(), time.Time{})
 
 	if err != nil {
 		return nil, err
@@ -17,6 +17,7 @@ import (
 	"github.com/linkerd/linkerd2/pkg/prometheus"
 	"github.com/linkerd/linkerd2/pkg/version"
 	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -37,6 +38,8 @@ type grpcServer struct {
 	controllerNamespace string
 	clusterDomain       string
 	ignoredNamespaces   []string
+	scrapeProxy         proxyScraper
+	queryLabels         model.LabelSet
 }
 
 type podReport struct {
@@ -45,7 +48,7 @@ type podReport struct {
 }
 
 const (
-	podQuery                   = "max(process_start_time_seconds{%s}) by (pod, namespace)"
+	podQuery                   = "max(process_start_time_seconds%s) by (pod, namespace)"
 	k8sClientSubsystemName     = "kubernetes"
 	k8sClientCheckDescription  = "control plane can talk to Kubernetes"
 	promClientSubsystemName    = "prometheus"
@@ -68,6 +71,7 @@ func newGrpcServer(
 		controllerNamespace: controllerNamespace,
 		clusterDomain:       clusterDomain,
 		ignoredNamespaces:   ignoredNamespaces,
+		scrapeProxy:         scrapeProxyMetrics,
 	}
 
 	pb.RegisterApiServer(prometheus.NewGrpcServer(), grpcServer)
@@ -101,7 +105,7 @@ func (s *grpcServer) ListPods(ctx context.Context, req *pb.ListPodsRequest) (*pb
 		}
 	}
 
-	nsQuery := ""
+	podLabels := model.LabelSet{}
 	namespace := ""
 	if req.GetNamespace() != "" {
 		namespace = req.GetNamespace()
@@ -111,12 +115,12 @@ func (s *grpcServer) ListPods(ctx context.Context, req *pb.ListPodsRequest) (*pb
 		namespace = targetOwner.GetName()
 	}
 	if namespace != "" {
-		nsQuery = fmt.Sprintf("namespace=\"%s\"", namespace)
+		podLabels[namespaceLabel] = model.LabelValue(namespace)
 	}
-	processStartTimeQuery := fmt.Sprintf(podQuery, nsQuery)
+	processStartTimeQuery := fmt.Sprintf(podQuery, s.withQueryLabels(podLabels).String())
 
 	// Query Prometheus for all pods present
-	vec, err := s.queryProm(ctx, processStartTimeQuery)
+	vec, err := s.queryProm(ctx, processStartTimeQuery, time.Time{})
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +215,7 @@ func (s *grpcServer) SelfCheck(ctx context.Context, in *healthcheckPb.SelfCheckR
 			CheckDescription: promClientCheckDescription,
 			Status:           healthcheckPb.CheckStatus_OK,
 		}
-		_, err = s.queryProm(ctx, fmt.Sprintf(podQuery, ""))
+		_, err = s.queryProm(ctx, fmt.Sprintf(podQuery, s.withQueryLabels(nil).String()), time.Time{})
 		if err != nil {
 			promClientCheck.Status = healthcheckPb.CheckStatus_ERROR
 			promClientCheck.FriendlyMessageToUser = fmt.Sprintf("Error calling Prometheus from the control plane: %s", err)
@@ -12,7 +12,6 @@ import (
 	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
 	"github.com/linkerd/linkerd2/pkg/protohttp"
-	promApi "github.com/prometheus/client_golang/api"
 	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/metadata"
@@ -300,7 +299,7 @@ func fullURLPathFor(method string) string {
 // NewServer creates a Public API HTTP server.
 func NewServer(
 	addr string,
-	prometheusClient promApi.Client,
+	metricsBackend *MetricsBackend,
 	destinationClient destinationPb.DestinationClient,
 	k8sAPI *k8s.API,
 	controllerNamespace string,
@@ -309,19 +308,24 @@ func NewServer(
 ) *http.Server {
 
 	var promAPI promv1.API
-	if prometheusClient != nil {
-		promAPI = promv1.NewAPI(prometheusClient)
+	if metricsBackend != nil {
+		promAPI = promv1.NewAPI(metricsBackend.client)
+	}
+
+	grpcServer := newGrpcServer(
+		promAPI,
+		destinationClient,
+		k8sAPI,
+		controllerNamespace,
+		clusterDomain,
+		ignoredNamespaces,
+	)
+	if metricsBackend != nil {
+		grpcServer.queryLabels = metricsBackend.labels
 	}
 
 	baseHandler := &handler{
-		grpcServer: newGrpcServer(
-			promAPI,
-			destinationClient,
-			k8sAPI,
-			controllerNamespace,
-			clusterDomain,
-			ignoredNamespaces,
-		),
+		grpcServer: grpcServer,
 	}
 
 	instrumentedHandler := prometheus.WithTelemetry(baseHandler)
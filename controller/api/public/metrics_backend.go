@@ -0,0 +1,104 @@
+package public
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	promApi "github.com/prometheus/client_golang/api"
+	"github.com/prometheus/common/model"
+)
+
+// MetricsBackendConfig configures the Prometheus-compatible API the public API
+// queries, such as Prometheus itself, Thanos, Cortex or VictoriaMetrics.
+type MetricsBackendConfig struct {
+	// URL of the API, including the path prefix of backends that serve the
+	// Prometheus API under one, such as Cortex's `/prometheus`.
+	URL string
+	// Headers are sent with every query, e.g. `X-Scope-OrgID` for
+	// multi-tenant backends.
+	Headers map[string]string
+	// BearerTokenFile is read on every query, so that rotated tokens are
+	// picked up.
+	BearerTokenFile string
+	// LabelMatchers are added to the selectors of every query.
+	LabelMatchers map[string]string
+	// ClusterLabel and ClusterName restrict the queries to the series of this
+	// cluster, in a Prometheus federating the series of several clusters.
+	ClusterLabel string
+	ClusterName  string
+}
+
+// MetricsBackend is the client of a metrics backend, with the label matchers
+// to add to every query.
+type MetricsBackend struct {
+	client promApi.Client
+	labels model.LabelSet
+}
+
+// NewMetricsBackend returns a client for the metrics backend configured by
+// config.
+func NewMetricsBackend(config MetricsBackendConfig) (*MetricsBackend, error) {
+	labels := model.LabelSet{}
+	for name, value := range config.LabelMatchers {
+		if !model.LabelName(name).IsValid() {
+			return nil, fmt.Errorf("invalid label name %q", name)
+		}
+		labels[model.LabelName(name)] = model.LabelValue(value)
+	}
+	if (config.ClusterLabel == "") != (config.ClusterName == "") {
+		return nil, fmt.Errorf("the cluster label and the cluster name must be set together")
+	}
+	if config.ClusterLabel != "" {
+		if !model.LabelName(config.ClusterLabel).IsValid() {
+			return nil, fmt.Errorf("invalid cluster label name %q", config.ClusterLabel)
+		}
+		labels[model.LabelName(config.ClusterLabel)] = model.LabelValue(config.ClusterName)
+	}
+
+	client, err := promApi.NewClient(promApi.Config{
+		Address: config.URL,
+		RoundTripper: &metricsBackendRoundTripper{
+			headers:         config.Headers,
+			bearerTokenFile: config.BearerTokenFile,
+			rt:              promApi.DefaultRoundTripper,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MetricsBackend{client: client, labels: labels}, nil
+}
+
+// metricsBackendRoundTripper adds the configured headers and bearer token to
+// the requests to the metrics backend.
+type metricsBackendRoundTripper struct {
+	headers         map[string]string
+	bearerTokenFile string
+	rt              http.RoundTripper
+}
+
+func (m *metricsBackendRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the request.
+	req = req.Clone(req.Context())
+	for name, value := range m.headers {
+		req.Header.Set(name, value)
+	}
+	if m.bearerTokenFile != "" {
+		token, err := ioutil.ReadFile(m.bearerTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the bearer token: %s", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+	return m.rt.RoundTrip(req)
+}
+
+// withQueryLabels adds the label matchers of the metrics backend to the
+// labels of a query. They take precedence, so that a query can't escape the
+// tenant or cluster they select.
+func (s *grpcServer) withQueryLabels(labels model.LabelSet) model.LabelSet {
+	return labels.Merge(s.queryLabels)
+}
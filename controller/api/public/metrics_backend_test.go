@@ -0,0 +1,164 @@
+package public
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/prometheus/common/model"
+)
+
+func TestNewMetricsBackend(t *testing.T) {
+	testCases := []struct {
+		name     string
+		config   MetricsBackendConfig
+		expected model.LabelSet
+		err      string
+	}{
+		{
+			"no labels",
+			MetricsBackendConfig{URL: "http://prometheus:9090"},
+			model.LabelSet{},
+			"",
+		},
+		{
+			"label matchers and cluster label",
+			MetricsBackendConfig{
+				URL:           "http://cortex/prometheus",
+				LabelMatchers: map[string]string{"env": "prod"},
+				ClusterLabel:  "cluster",
+				ClusterName:   "east",
+			},
+			model.LabelSet{"env": "prod", "cluster": "east"},
+			"",
+		},
+		{
+			"invalid label name",
+			MetricsBackendConfig{URL: "http://prometheus:9090", LabelMatchers: map[string]string{"linkerd.io/env": "prod"}},
+			nil,
+			`invalid label name "linkerd.io/env"`,
+		},
+		{
+			"cluster label without cluster name",
+			MetricsBackendConfig{URL: "http://prometheus:9090", ClusterLabel: "cluster"},
+			nil,
+			"the cluster label and the cluster name must be set together",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			backend, err := NewMetricsBackend(tc.config)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("Expected error %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !backend.labels.Equal(tc.expected) {
+				t.Fatalf("Expected labels %s, got %s", tc.expected, backend.labels)
+			}
+		})
+	}
+}
+
+func TestMetricsBackendRoundTripper(t *testing.T) {
+	dir, err := ioutil.TempDir("", "metrics-backend")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	tokenFile := filepath.Join(dir, "token")
+	if err := ioutil.WriteFile(tokenFile, []byte("s3cr3t\n"), 0600); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	var received http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header
+	}))
+	defer ts.Close()
+
+	rt := &metricsBackendRoundTripper{
+		headers:         map[string]string{"X-Scope-OrgID": "tenant-1"},
+		bearerTokenFile: tokenFile,
+		rt:              http.DefaultTransport,
+	}
+	req, err := http.NewRequest(http.MethodGet, ts.URL, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	rsp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	rsp.Body.Close()
+
+	if received.Get("X-Scope-OrgID") != "tenant-1" {
+		t.Fatalf("Expected the X-Scope-OrgID header to be tenant-1, got %q", received.Get("X-Scope-OrgID"))
+	}
+	if received.Get("Authorization") != "Bearer s3cr3t" {
+		t.Fatalf("Expected the bearer token to be sent, got %q", received.Get("Authorization"))
+	}
+	if req.Header.Get("Authorization") != "" {
+		t.Fatal("Expected the original request to be left unmodified")
+	}
+}
+
+func TestQueryLabels(t *testing.T) {
+	exp := expectedStatRPC{
+		k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-1
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: linkerd
+status:
+  phase: Running
+`,
+		},
+		mockPromResponse: model.Vector{},
+		expectedPrometheusQueries: []string{
+			`histogram_quantile(0.5, sum(irate(response_latency_ms_bucket{cluster="east", direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+			`histogram_quantile(0.95, sum(irate(response_latency_ms_bucket{cluster="east", direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+			`histogram_quantile(0.99, sum(irate(response_latency_ms_bucket{cluster="east", direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+			`sum(increase(response_total{cluster="east", direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (namespace, pod, classification, tls)`,
+		},
+	}
+
+	mockProm, fakeGrpcServer, err := newMockGrpcServer(exp)
+	if err != nil {
+		t.Fatalf("Error creating mock grpc server: %s", err)
+	}
+	fakeGrpcServer.queryLabels = model.LabelSet{"cluster": "east"}
+
+	_, err = fakeGrpcServer.StatSummary(context.Background(), &pb.StatSummaryRequest{
+		Selector: &pb.ResourceSelection{
+			Resource: &pb.Resource{
+				Name:      "emojivoto-1",
+				Namespace: "emojivoto",
+				Type:      pkgK8s.Pod,
+			},
+		},
+		TimeWindow: "1m",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := exp.verifyPromQueries(mockProm); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/prometheus/common/model"
@@ -50,7 +52,43 @@ func extractSampleValue(sample *model.Sample) uint64 {
 	return value
 }
 
-func (s *grpcServer) queryProm(ctx context.Context, query string) (model.Vector, error) {
+// queryTimeWindow returns the time window of the queries of a request: the
+// span of its time range if it has a start time, timeWindow otherwise.
+func queryTimeWindow(startTime, endTime *timestamp.Timestamp, timeWindow string) (string, error) {
+	end := time.Now()
+	if endTime != nil {
+		t, err := ptypes.Timestamp(endTime)
+		if err != nil {
+			return "", fmt.Errorf("invalid end time: %s", err)
+		}
+		end = t
+	}
+	if startTime == nil {
+		return timeWindow, nil
+	}
+
+	start, err := ptypes.Timestamp(startTime)
+	if err != nil {
+		return "", fmt.Errorf("invalid start time: %s", err)
+	}
+	if !start.Before(end) {
+		return "", errors.New("start time needs to be before end time")
+	}
+	return model.Duration(end.Sub(start)).String(), nil
+}
+
+// queryTime returns the evaluation time of the queries of a request, or the
+// zero time, which Prometheus evaluates as now, if it has no end time.
+func queryTime(endTime *timestamp.Timestamp) time.Time {
+	if endTime == nil {
+		return time.Time{}
+	}
+	// The end time is validated by queryTimeWindow.
+	t, _ := ptypes.Timestamp(endTime)
+	return t
+}
+
+func (s *grpcServer) queryProm(ctx context.Context, query string, at time.Time) (model.Vector, error) {
 	log.Debugf("Query request:\n\t%+v", query)
 
 	_, span := trace.StartSpan(ctx, "query.prometheus")
@@ -62,7 +100,7 @@ func (s *grpcServer) queryProm(ctx context.Context, query string) (model.Vector,
 	}
 
 	// single data point (aka summary) query
-	res, warn, err := s.prometheusAPI.Query(ctx, query, time.Time{})
+	res, warn, err := s.prometheusAPI.Query(ctx, query, at)
 	if err != nil {
 		log.Errorf("Query(%+v) failed with: %+v", query, err)
 		return nil, err
@@ -180,7 +218,7 @@ func promResourceType(resource *pb.Resource) model.LabelName {
 	return model.LabelName(l5dLabel)
 }
 
-func (s *grpcServer) getPrometheusMetrics(ctx context.Context, requestQueryTemplates map[promType]string, latencyQueryTemplate, labels, timeWindow, groupBy string) ([]promResult, error) {
+func (s *grpcServer) getPrometheusMetrics(ctx context.Context, requestQueryTemplates map[promType]string, latencyQueryTemplate, labels, timeWindow, groupBy string, at time.Time) ([]promResult, error) {
 	resultChan := make(chan promResult)
 
 	// kick off asynchronous queries: request count queries + 3 latency queries
@@ -193,7 +231,7 @@ func (s *grpcServer) getPrometheusMetrics(ctx context.Context, requestQueryTempl
 		}
 
 		go func(typ promType, promQuery string) {
-			resultVector, err := s.queryProm(ctx, promQuery)
+			resultVector, err := s.queryProm(ctx, promQuery, at)
 			resultChan <- promResult{
 				prom: typ,
 				vec:  resultVector,
@@ -207,7 +245,7 @@ func (s *grpcServer) getPrometheusMetrics(ctx context.Context, requestQueryTempl
 	for _, quantile := range quantiles {
 		go func(quantile promType) {
 			latencyQuery := fmt.Sprintf(latencyQueryTemplate, quantile, labels, timeWindow, groupBy)
-			latencyResult, err := s.queryProm(ctx, latencyQuery)
+			latencyResult, err := s.queryProm(ctx, latencyQuery, at)
 
 			resultChan <- promResult{
 				prom: quantile,
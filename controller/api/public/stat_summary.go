@@ -92,6 +92,24 @@ func (s *grpcServer) StatSummary(ctx context.Context, req *pb.StatSummaryRequest
 		}
 	}
 
+	if req.Direct {
+		ctx = withDirectScrapes(ctx)
+	}
+
+	timeWindow, err := queryTimeWindow(req.GetStartTime(), req.GetEndTime(), req.TimeWindow)
+	if err != nil {
+		return statSummaryError(req, err.Error()), nil
+	}
+	if req.Direct && (req.GetStartTime() != nil || req.GetEndTime() != nil) {
+		return statSummaryError(req, "direct stats only cover the time window ending now"), nil
+	}
+	if req.Direct {
+		if _, err := directTimeWindow(timeWindow); err != nil {
+			return statSummaryError(req, err.Error()), nil
+		}
+	}
+	req.TimeWindow = timeWindow
+
 	statTables := make([]*pb.StatTable, 0)
 
 	var resourcesToQuery []string
@@ -501,8 +519,14 @@ func (s *grpcServer) getStatMetrics(ctx context.Context, req *pb.StatSummaryRequ
 		promQueries[promTCPReadBytes] = tcpReadBytesQuery
 		promQueries[promTCPWriteBytes] = tcpWriteBytesQuery
 	}
-	results, err := s.getPrometheusMetrics(ctx, promQueries, latencyQuantileQuery, reqLabels.String(), timeWindow, groupBy.String())
 
+	var results []promResult
+	var err error
+	if req.Direct {
+		results, err = s.getDirectMetrics(ctx, promTypes(promQueries), directMatchers(reqLabels, "", ""), timeWindow, groupBy)
+	} else {
+		results, err = s.getPrometheusMetrics(ctx, promQueries, latencyQuantileQuery, s.withQueryLabels(reqLabels).String(), timeWindow, groupBy.String(), queryTime(req.GetEndTime()))
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -520,14 +544,18 @@ func (s *grpcServer) getTrafficSplitMetrics(ctx context.Context, req *pb.StatSum
 	// TODO: add cluster domain to stringToMatch
 	stringToMatch := fmt.Sprintf("%s.%s.svc", apex, namespace)
 
-	reqLabels := generateLabelStringWithRegex(labels, "authority", stringToMatch)
-
 	promQueries := map[promType]string{
 		promRequests: reqQuery,
 	}
 
-	results, err := s.getPrometheusMetrics(ctx, promQueries, latencyQuantileQuery, reqLabels, timeWindow, groupBy.String())
-
+	var results []promResult
+	var err error
+	if req.Direct {
+		results, err = s.getDirectMetrics(ctx, promTypes(promQueries), directMatchers(labels, "authority", stringToMatch), timeWindow, groupBy)
+	} else {
+		reqLabels := generateLabelStringWithRegex(s.withQueryLabels(labels), "authority", stringToMatch)
+		results, err = s.getPrometheusMetrics(ctx, promQueries, latencyQuantileQuery, reqLabels, timeWindow, groupBy.String(), queryTime(req.GetEndTime()))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
@@ -1895,4 +1897,91 @@ status:
 
 		testStatSummary(t, expectations)
 	})
+
+	t.Run("Queries prometheus at the end of the requested time range", func(t *testing.T) {
+		exp := expectedStatRPC{
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-1
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: linkerd
+status:
+  phase: Running
+`,
+			},
+			mockPromResponse: model.Vector{},
+			expectedPrometheusQueries: []string{
+				`histogram_quantile(0.5, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[90m])) by (le, namespace, pod))`,
+				`histogram_quantile(0.95, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[90m])) by (le, namespace, pod))`,
+				`histogram_quantile(0.99, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[90m])) by (le, namespace, pod))`,
+				`sum(increase(response_total{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[90m])) by (namespace, pod, classification, tls)`,
+			},
+		}
+		mockProm, fakeGrpcServer, err := newMockGrpcServer(exp)
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		end := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+		startTime, _ := ptypes.TimestampProto(end.Add(-90 * time.Minute))
+		endTime, _ := ptypes.TimestampProto(end)
+		rsp, err := fakeGrpcServer.StatSummary(context.TODO(), &pb.StatSummaryRequest{
+			Selector: &pb.ResourceSelection{
+				Resource: &pb.Resource{
+					Name:      "emojivoto-1",
+					Namespace: "emojivoto",
+					Type:      pkgK8s.Pod,
+				},
+			},
+			TimeWindow: "1m",
+			StartTime:  startTime,
+			EndTime:    endTime,
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if err := exp.verifyPromQueries(mockProm); err != nil {
+			t.Fatal(err)
+		}
+		for _, ts := range mockProm.QueryTimes {
+			if !ts.Equal(end) {
+				t.Fatalf("Expected queries to be evaluated at %s, got %s", end, ts)
+			}
+		}
+		row := rsp.GetOk().GetStatTables()[0].GetPodGroup().GetRows()[0]
+		if row.TimeWindow != "90m" {
+			t.Fatalf("Expected time window 90m, got %s", row.TimeWindow)
+		}
+	})
+
+	t.Run("Rejects time ranges starting after they end", func(t *testing.T) {
+		_, fakeGrpcServer, err := newMockGrpcServer(expectedStatRPC{})
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		end := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+		startTime, _ := ptypes.TimestampProto(end.Add(time.Minute))
+		endTime, _ := ptypes.TimestampProto(end)
+		rsp, err := fakeGrpcServer.StatSummary(context.TODO(), &pb.StatSummaryRequest{
+			Selector: &pb.ResourceSelection{
+				Resource: &pb.Resource{
+					Namespace: "emojivoto",
+					Type:      pkgK8s.Pod,
+				},
+			},
+			StartTime: startTime,
+			EndTime:   endTime,
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if rsp.GetError().GetError() != "start time needs to be before end time" {
+			t.Fatalf("Unexpected response: %s", rsp)
+		}
+	})
 }
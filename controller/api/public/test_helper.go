@@ -162,7 +162,8 @@ func BuildAddrSet(endpoint AuthorityEndpoints) *destinationPb.WeightedAddrSet {
 // TODO: move this into something shared under /controller, or into /pkg
 type MockProm struct {
 	Res             model.Value
-	QueriesExecuted []string // expose the queries our Mock Prometheus receives, to test query generation
+	QueriesExecuted []string    // expose the queries our Mock Prometheus receives, to test query generation
+	QueryTimes      []time.Time // the evaluation times of the instant queries
 	rwLock          sync.Mutex
 }
 
@@ -181,6 +182,7 @@ func (m *MockProm) Query(ctx context.Context, query string, ts time.Time) (model
 	m.rwLock.Lock()
 	defer m.rwLock.Unlock()
 	m.QueriesExecuted = append(m.QueriesExecuted, query)
+	m.QueryTimes = append(m.QueryTimes, ts)
 	return m.Res, nil, nil
 }
 
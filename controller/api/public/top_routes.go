@@ -50,6 +50,12 @@ func (s *grpcServer) TopRoutes(ctx context.Context, req *pb.TopRoutesRequest) (*
 		return errRsp, nil
 	}
 
+	timeWindow, err := queryTimeWindow(req.GetStartTime(), req.GetEndTime(), req.TimeWindow)
+	if err != nil {
+		return topRoutesError(req, err.Error()), nil
+	}
+	req.TimeWindow = timeWindow
+
 	// TopRoutes will return one table for each resource object requested.
 	tables := make([]resourceTable, 0)
 	targetResource := req.GetSelector().GetResource()
@@ -248,7 +254,7 @@ func (s *grpcServer) getRouteMetrics(ctx context.Context, req *pb.TopRoutesReque
 		queries[promActualRequests] = actualRouteReqQuery
 	}
 
-	results, err := s.getPrometheusMetrics(ctx, queries, routeLatencyQuantileQuery, reqLabels, timeWindow, groupBy)
+	results, err := s.getPrometheusMetrics(ctx, queries, routeLatencyQuantileQuery, reqLabels, timeWindow, groupBy, queryTime(req.GetEndTime()))
 	if err != nil {
 		return nil, err
 	}
@@ -291,12 +297,12 @@ func (s *grpcServer) buildRouteLabels(req *pb.TopRoutesRequest, dsts []string, r
 	case *pb.TopRoutesRequest_ToResource:
 		labels = labels.Merge(promQueryLabels(resource))
 		labels = labels.Merge(promDirectionLabels("outbound"))
-		return renderLabels(labels, dsts)
+		return renderLabels(s.withQueryLabels(labels), dsts)
 
 	default:
 		labels = labels.Merge(promDirectionLabels("inbound"))
 		labels = labels.Merge(promQueryLabels(resource))
-		return renderLabels(labels, dsts)
+		return renderLabels(s.withQueryLabels(labels), dsts)
 	}
 }
 
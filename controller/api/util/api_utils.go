@@ -7,6 +7,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	"google.golang.org/grpc/codes"
@@ -61,6 +63,8 @@ var (
 // for metrics data.  This includes requests to StatSummary and TopRoutes.
 type StatsBaseRequestParams struct {
 	TimeWindow    string
+	StartTime     string
+	EndTime       string
 	Namespace     string
 	ResourceType  string
 	ResourceName  string
@@ -79,6 +83,7 @@ type StatsSummaryRequestParams struct {
 	FromName      string
 	SkipStats     bool
 	TCPStats      bool
+	Direct        bool
 	LabelSelector string
 }
 
@@ -88,6 +93,8 @@ type EdgesRequestParams struct {
 	Namespace     string
 	ResourceType  string
 	AllNamespaces bool
+	StartTime     string
+	EndTime       string
 }
 
 // TopRoutesRequestParams contains parameters that are used to build TopRoutes
@@ -201,6 +208,12 @@ func BuildStatSummaryRequest(p StatsSummaryRequestParams) (*pb.StatSummaryReques
 		TimeWindow: window,
 		SkipStats:  p.SkipStats,
 		TcpStats:   p.TCPStats,
+		Direct:     p.Direct,
+	}
+
+	statRequest.StartTime, statRequest.EndTime, err = buildTimeRange(p.StartTime, p.EndTime)
+	if err != nil {
+		return nil, err
 	}
 
 	if p.ToName != "" || p.ToType != "" || p.ToNamespace != "" {
@@ -273,9 +286,47 @@ func BuildEdgesRequest(p EdgesRequestParams) (*pb.EdgesRequest, error) {
 		},
 	}
 
+	edgesRequest.StartTime, edgesRequest.EndTime, err = buildTimeRange(p.StartTime, p.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
 	return edgesRequest, nil
 }
 
+// buildTimeRange parses the optional RFC 3339 start and end times of a
+// request. The end time defaults to now when only the start time is set.
+func buildTimeRange(startTime, endTime string) (*timestamp.Timestamp, *timestamp.Timestamp, error) {
+	end := time.Now()
+	var endTS *timestamp.Timestamp
+	if endTime != "" {
+		t, err := time.Parse(time.RFC3339, endTime)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid end time: %s", err)
+		}
+		end = t
+		if endTS, err = ptypes.TimestampProto(t); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if startTime == "" {
+		return nil, endTS, nil
+	}
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid start time: %s", err)
+	}
+	if !start.Before(end) {
+		return nil, nil, errors.New("the start time needs to be before the end time")
+	}
+	startTS, err := ptypes.TimestampProto(start)
+	if err != nil {
+		return nil, nil, err
+	}
+	return startTS, endTS, nil
+}
+
 // BuildTopRoutesRequest builds a Public API TopRoutesRequest from a
 // TopRoutesRequestParams.
 func BuildTopRoutesRequest(p TopRoutesRequestParams) (*pb.TopRoutesRequest, error) {
@@ -316,6 +367,11 @@ func BuildTopRoutesRequest(p TopRoutesRequestParams) (*pb.TopRoutesRequest, erro
 		TimeWindow: window,
 	}
 
+	topRoutesRequest.StartTime, topRoutesRequest.EndTime, err = buildTimeRange(p.StartTime, p.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
 	if p.ToName != "" || p.ToType != "" || p.ToNamespace != "" {
 		if p.ToNamespace == "" {
 			p.ToNamespace = targetNamespace
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
@@ -93,6 +94,67 @@ func TestBuildStatSummaryRequest(t *testing.T) {
 		}
 	})
 
+	t.Run("Sets the direct option", func(t *testing.T) {
+		statSummaryRequest, err := BuildStatSummaryRequest(
+			StatsSummaryRequestParams{
+				StatsBaseRequestParams: StatsBaseRequestParams{
+					ResourceType: k8s.Deployment,
+				},
+				Direct: true,
+			},
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error from BuildStatSummaryRequest: %s", err)
+		}
+		if !statSummaryRequest.Direct {
+			t.Fatal("Expected BuildStatSummaryRequest to set Direct")
+		}
+	})
+
+	t.Run("Parses time ranges", func(t *testing.T) {
+		statSummaryRequest, err := BuildStatSummaryRequest(
+			StatsSummaryRequestParams{
+				StatsBaseRequestParams: StatsBaseRequestParams{
+					ResourceType: k8s.Deployment,
+					StartTime:    "2020-10-01T12:00:00Z",
+					EndTime:      "2020-10-01T12:30:00+00:00",
+				},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error from BuildStatSummaryRequest: %s", err)
+		}
+		if statSummaryRequest.StartTime.GetSeconds() != 1601553600 || statSummaryRequest.EndTime.GetSeconds() != 1601555400 {
+			t.Fatalf("Unexpected time range from BuildStatSummaryRequest: %s - %s", statSummaryRequest.StartTime, statSummaryRequest.EndTime)
+		}
+	})
+
+	t.Run("Rejects invalid time ranges", func(t *testing.T) {
+		expectations := []struct {
+			startTime string
+			endTime   string
+			msg       string
+		}{
+			{"2020-10-01T12:30:00Z", "2020-10-01T12:00:00Z", "the start time needs to be before the end time"},
+			{"", "12:00", "invalid end time: "},
+		}
+
+		for _, exp := range expectations {
+			_, err := BuildStatSummaryRequest(
+				StatsSummaryRequestParams{
+					StatsBaseRequestParams: StatsBaseRequestParams{
+						ResourceType: k8s.Deployment,
+						StartTime:    exp.startTime,
+						EndTime:      exp.endTime,
+					},
+				},
+			)
+			if err == nil || !strings.HasPrefix(err.Error(), exp.msg) {
+				t.Fatalf("BuildStatSummaryRequest(%s, %s) should have returned: %s but got: %v", exp.startTime, exp.endTime, exp.msg, err)
+			}
+		}
+	})
+
 	t.Run("Rejects invalid time windows", func(t *testing.T) {
 		expectations := map[string]string{
 			"1": "time: missing unit in duration 1",
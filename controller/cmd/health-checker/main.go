@@ -0,0 +1,95 @@
+package healthchecker
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	healthchecker "github.com/linkerd/linkerd2/controller/health-checker"
+	"github.com/linkerd/linkerd2/pkg/admin"
+	"github.com/linkerd/linkerd2/pkg/flags"
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+const componentName = "linkerd-health-checker"
+
+// Main executes the health-checker subcommand
+func Main(args []string) {
+	cmd := flag.NewFlagSet("health-checker", flag.ExitOnError)
+
+	kubeConfigPath := cmd.String("kubeconfig", "", "path to kube config")
+	metricsAddr := cmd.String("metrics-addr", ":9993", "address to serve scrapable metrics on")
+	controllerNamespace := cmd.String("controller-namespace", "linkerd", "namespace in which Linkerd is installed")
+	apiAddr := cmd.String("api-addr", "", "address of the public API; reached through a port-forward if empty")
+	categories := cmd.String("categories", joinCategories(healthchecker.DefaultCategories), "comma-separated list of the categories of checks to run")
+	interval := cmd.Duration("interval", 5*time.Minute, "interval at which the checks are run")
+
+	flags.ConfigureAndParse(cmd, args)
+
+	if *interval <= 0 {
+		log.Fatalf("Invalid interval: %s", *interval)
+	}
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+
+	k8sAPI, err := k8s.NewAPI(*kubeConfigPath, "", "", []string{}, 0)
+	if err != nil {
+		log.Fatalf("Failed to initialize K8s API: %s", err)
+	}
+
+	// Events are emitted on the health-checker's Deployment
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+		Interface: k8sAPI.CoreV1().Events(*controllerNamespace),
+	})
+	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: componentName})
+	deployment, err := k8sAPI.AppsV1().Deployments(*controllerNamespace).Get(context.Background(), componentName, metav1.GetOptions{})
+	if err != nil {
+		log.Fatalf("Failed to construct k8s event recorder: %s", err)
+	}
+
+	checker := healthchecker.NewChecker(splitCategories(*categories), &healthcheck.Options{
+		ControlPlaneNamespace: *controllerNamespace,
+		KubeConfig:            *kubeConfigPath,
+		APIAddr:               *apiAddr,
+	}, recorder, deployment)
+
+	go admin.StartServer(*metricsAddr)
+
+	done := make(chan struct{})
+	go checker.Run(*interval, done)
+
+	<-stop
+	log.Info("shutting down health checker")
+	close(done)
+}
+
+func joinCategories(categories []healthcheck.CategoryID) string {
+	ids := []string{}
+	for _, category := range categories {
+		ids = append(ids, string(category))
+	}
+	return strings.Join(ids, ",")
+}
+
+func splitCategories(categories string) []healthcheck.CategoryID {
+	ids := []healthcheck.CategoryID{}
+	for _, id := range strings.Split(categories, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, healthcheck.CategoryID(id))
+		}
+	}
+	return ids
+}
@@ -2,10 +2,13 @@ package identity
 
 import (
 	"context"
+	cryptotls "crypto/tls"
 	"encoding/base64"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -13,9 +16,11 @@ import (
 	"time"
 
 	idctl "github.com/linkerd/linkerd2/controller/identity"
+	ctlk8s "github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/admin"
 	"github.com/linkerd/linkerd2/pkg/flags"
 	"github.com/linkerd/linkerd2/pkg/identity"
+	"github.com/linkerd/linkerd2/pkg/identity/signer"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
 	"github.com/linkerd/linkerd2/pkg/tls"
@@ -29,6 +34,11 @@ import (
 	"k8s.io/client-go/tools/record"
 )
 
+const (
+	signerVault       = "vault"
+	signerCertManager = "cert-manager"
+)
+
 // Main executes the identity subcommand
 func Main(args []string) {
 	cmd := flag.NewFlagSet("identity", flag.ExitOnError)
@@ -43,9 +53,28 @@ func Main(args []string) {
 	identityIssuanceLifeTime := cmd.String("identity-issuance-lifetime", "", "the amount of time for which the Identity issuer should certify identity")
 	identityClockSkewAllowance := cmd.String("identity-clock-skew-allowance", "", "the amount of time to allow for clock skew within a Linkerd cluster")
 
+	tokenAudience := cmd.String("token-audience", "",
+		"audience proxies' service account tokens must be bound to; any token is accepted if empty")
+	allowLegacyTokens := cmd.Bool("allow-legacy-tokens", false,
+		"also accept tokens that aren't bound to the token audience, e.g. from proxies injected before projected tokens were used")
+	externalWorkloadJoinKeyPath := cmd.String("external-workload-join-key", "",
+		"path to the file holding the key join tokens of external workloads are signed with; join tokens are rejected if empty")
 	issuerPath := cmd.String("issuer",
 		"/var/run/linkerd/identity/issuer",
 		"path to directory containing issuer credentials")
+	issuerReloadInterval := cmd.Duration("issuer-reload-interval", time.Minute,
+		"interval at which issuer credentials are re-read from disk, in case changes weren't notified; never if zero")
+
+	externalSigner := cmd.String("external-signer", "",
+		fmt.Sprintf("forward CSRs to an external signer instead of signing with the issuer credentials; one of: %s, %s", signerVault, signerCertManager))
+	vaultAddr := cmd.String("vault-addr", "", "address of the Vault server signing CSRs")
+	vaultSignPath := cmd.String("vault-sign-path", "", "path of the Vault PKI sign endpoint, e.g. pki_int/sign/linkerd-identity")
+	vaultTokenPath := cmd.String("vault-token-path", "", "path to the file holding the Vault token")
+	vaultNamespace := cmd.String("vault-namespace", "", "Vault Enterprise namespace")
+	vaultCAPath := cmd.String("vault-ca-path", "", "path to the CA bundle used to verify the Vault server")
+	certManagerIssuerName := cmd.String("cert-manager-issuer-name", "", "name of the cert-manager issuer signing CSRs")
+	certManagerIssuerKind := cmd.String("cert-manager-issuer-kind", "Issuer", "kind of the cert-manager issuer signing CSRs")
+	certManagerIssuerGroup := cmd.String("cert-manager-issuer-group", "cert-manager.io", "API group of the cert-manager issuer signing CSRs")
 
 	var issuerPathCrt string
 	var issuerPathKey string
@@ -114,14 +143,17 @@ func Main(args []string) {
 	issuerError := make(chan error)
 
 	//
-	// Create and start FS creds watcher
+	// Create and start FS creds watcher, unless CSRs are forwarded to an
+	// external signer
 	//
-	watcher := idctl.NewFsCredsWatcher(*issuerPath, issuerEvent, issuerError)
-	go func() {
-		if err := watcher.StartWatching(ctx); err != nil {
-			log.Fatalf("Failed to start creds watcher: %s", err)
-		}
-	}()
+	if *externalSigner == "" {
+		watcher := idctl.NewFsCredsWatcher(*issuerPath, issuerEvent, issuerError)
+		go func() {
+			if err := watcher.StartWatching(ctx); err != nil {
+				log.Fatalf("Failed to start creds watcher: %s", err)
+			}
+		}()
+	}
 
 	//
 	// Create k8s API
@@ -130,11 +162,27 @@ func Main(args []string) {
 	if err != nil {
 		log.Fatalf("Failed to load kubeconfig: %s: %s", *kubeConfigPath, err)
 	}
-	v, err := idctl.NewK8sTokenValidator(ctx, k8sAPI, dom)
+	v, err := idctl.NewK8sTokenValidator(ctx, k8sAPI, dom, *tokenAudience, *allowLegacyTokens)
 	if err != nil {
 		log.Fatalf("Failed to initialize identity service: %s", err)
 	}
 
+	//
+	// Watch the identity deny-lists and external workloads
+	//
+	ctlAPI, err := ctlk8s.InitializeAPI(ctx, *kubeConfigPath, false, ctlk8s.IDL, ctlk8s.EW)
+	if err != nil {
+		log.Fatalf("Failed to initialize K8s API: %s", err)
+	}
+	var joinKey []byte
+	if *externalWorkloadJoinKeyPath != "" {
+		joinKey, err = ioutil.ReadFile(*externalWorkloadJoinKeyPath)
+		if err != nil && !os.IsNotExist(err) {
+			log.Fatalf("Failed to read external workload join key: %s", err)
+		}
+	}
+	ewv := idctl.NewExternalWorkloadValidator(ctlAPI, dom, joinKey, v)
+
 	// Create K8s event recorder
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
@@ -154,7 +202,55 @@ func Main(args []string) {
 	//
 	// Create, initialize and run service
 	//
-	svc := identity.NewService(v, trustAnchors, &validity, recordEventFunc, expectedName, issuerPathCrt, issuerPathKey)
+	var svc *identity.Service
+	switch *externalSigner {
+	case "":
+		svc = identity.NewService(ewv, trustAnchors, &validity, recordEventFunc, expectedName, issuerPathCrt, issuerPathKey)
+	case signerVault:
+		client := &http.Client{Timeout: 30 * time.Second}
+		if *vaultCAPath != "" {
+			caPEM, err := ioutil.ReadFile(*vaultCAPath)
+			if err != nil {
+				log.Fatalf("Failed to read Vault CA bundle: %s", err)
+			}
+			pool, err := tls.DecodePEMCertPool(string(caPEM))
+			if err != nil {
+				log.Fatalf("Failed to decode Vault CA bundle: %s", err)
+			}
+			client.Transport = &http.Transport{TLSClientConfig: &cryptotls.Config{RootCAs: pool}}
+		}
+		issuer, err := signer.NewVaultIssuer(signer.VaultConfig{
+			Addr:      *vaultAddr,
+			SignPath:  *vaultSignPath,
+			TokenPath: *vaultTokenPath,
+			Namespace: *vaultNamespace,
+			Client:    client,
+			SpiffeIDs: dom,
+		}, trustAnchors, validity)
+		if err != nil {
+			log.Fatalf("Failed to configure Vault signer: %s", err)
+		}
+		svc = identity.NewExternalService(ewv, trustAnchors, &validity, recordEventFunc, expectedName, issuer)
+	case signerCertManager:
+		issuer, err := signer.NewCertManagerIssuer(k8sAPI.DynamicClient, signer.CertManagerConfig{
+			Namespace:   *controllerNS,
+			IssuerName:  *certManagerIssuerName,
+			IssuerKind:  *certManagerIssuerKind,
+			IssuerGroup: *certManagerIssuerGroup,
+		}, trustAnchors, validity)
+		if err != nil {
+			log.Fatalf("Failed to configure cert-manager signer: %s", err)
+		}
+		svc = identity.NewExternalService(ewv, trustAnchors, &validity, recordEventFunc, expectedName, issuer)
+	default:
+		log.Fatalf("Unsupported external signer: %s", *externalSigner)
+	}
+
+	svc.SetDenyList(idctl.NewDenyList(ctlAPI, log.WithField("service", "identity")))
+	svc.SetSpiffeIDs(dom)
+	svc.SetReloadInterval(*issuerReloadInterval)
+	ctlAPI.Sync(nil) // blocks until caches are synced
+
 	if err = svc.Initialize(); err != nil {
 		log.Fatalf("Failed to initialize identity service: %s", err)
 	}
@@ -165,7 +261,7 @@ func Main(args []string) {
 	//
 	// Bind and serve
 	//
-	go admin.StartServer(*adminAddr)
+	go admin.StartServerWithReadiness(*adminAddr, svc.Ready)
 	lis, err := net.Listen("tcp", *addr)
 	if err != nil {
 		log.Fatalf("Failed to listen on %s: %s", *addr, err)
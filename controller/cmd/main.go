@@ -7,6 +7,7 @@ import (
 	servicemirror "github.com/linkerd/linkerd2/controller/cmd/service-mirror"
 
 	"github.com/linkerd/linkerd2/controller/cmd/destination"
+	healthchecker "github.com/linkerd/linkerd2/controller/cmd/health-checker"
 	"github.com/linkerd/linkerd2/controller/cmd/heartbeat"
 	"github.com/linkerd/linkerd2/controller/cmd/identity"
 	proxyinjector "github.com/linkerd/linkerd2/controller/cmd/proxy-injector"
@@ -24,6 +25,8 @@ func main() {
 	switch os.Args[1] {
 	case "destination":
 		destination.Main(os.Args[2:])
+	case "health-checker":
+		healthchecker.Main(os.Args[2:])
 	case "heartbeat":
 		heartbeat.Main(os.Args[2:])
 	case "identity":
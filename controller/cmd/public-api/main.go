@@ -3,6 +3,7 @@ package publicapi
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
 	"os/signal"
 	"strings"
@@ -14,7 +15,6 @@ import (
 	"github.com/linkerd/linkerd2/pkg/admin"
 	"github.com/linkerd/linkerd2/pkg/flags"
 	"github.com/linkerd/linkerd2/pkg/trace"
-	promApi "github.com/prometheus/client_golang/api"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -25,6 +25,11 @@ func Main(args []string) {
 	addr := cmd.String("addr", ":8085", "address to serve on")
 	kubeConfigPath := cmd.String("kubeconfig", "", "path to kube config")
 	prometheusURL := cmd.String("prometheus-url", "", "prometheus url")
+	prometheusHeaders := cmd.String("prometheus-headers", "", "comma separated list of name=value HTTP headers to send to prometheus, such as X-Scope-OrgID=<tenant>")
+	prometheusBearerTokenFile := cmd.String("prometheus-bearer-token-file", "", "path to a file holding a bearer token to send to prometheus")
+	prometheusLabels := cmd.String("prometheus-label-matchers", "", "comma separated list of name=value label matchers to add to every prometheus query")
+	prometheusClusterLabel := cmd.String("prometheus-cluster-label", "", "label identifying the series of this cluster in a federated prometheus")
+	prometheusClusterName := cmd.String("prometheus-cluster-name", "", "value of the cluster label of the series of this cluster")
 	metricsAddr := cmd.String("metrics-addr", ":9995", "address to serve scrapable metrics on")
 	destinationAPIAddr := cmd.String("destination-addr", "127.0.0.1:8086", "address of destination service")
 	controllerNamespace := cmd.String("controller-namespace", "linkerd", "namespace in which Linkerd is installed")
@@ -55,9 +60,24 @@ func Main(args []string) {
 		log.Fatalf("Failed to initialize K8s API: %s", err)
 	}
 
-	var prometheusClient promApi.Client
+	var metricsBackend *public.MetricsBackend
 	if *prometheusURL != "" {
-		prometheusClient, err = promApi.NewClient(promApi.Config{Address: *prometheusURL})
+		headers, err := parseKeyValues(*prometheusHeaders)
+		if err != nil {
+			log.Fatalf("Invalid -prometheus-headers: %s", err)
+		}
+		labels, err := parseKeyValues(*prometheusLabels)
+		if err != nil {
+			log.Fatalf("Invalid -prometheus-label-matchers: %s", err)
+		}
+		metricsBackend, err = public.NewMetricsBackend(public.MetricsBackendConfig{
+			URL:             *prometheusURL,
+			Headers:         headers,
+			BearerTokenFile: *prometheusBearerTokenFile,
+			LabelMatchers:   labels,
+			ClusterLabel:    *prometheusClusterLabel,
+			ClusterName:     *prometheusClusterName,
+		})
 		if err != nil {
 			log.Fatal(err.Error())
 		}
@@ -73,7 +93,7 @@ func Main(args []string) {
 
 	server := public.NewServer(
 		*addr,
-		prometheusClient,
+		metricsBackend,
 		destinationClient,
 		k8sAPI,
 		*controllerNamespace,
@@ -95,3 +115,19 @@ func Main(args []string) {
 	log.Infof("shutting down HTTP server on %+v", *addr)
 	server.Shutdown(ctx)
 }
+
+// parseKeyValues parses a comma separated list of name=value pairs.
+func parseKeyValues(s string) (map[string]string, error) {
+	values := map[string]string{}
+	if s == "" {
+		return values, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("expected name=value, got %q", pair)
+		}
+		values[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return values, nil
+}
@@ -32,6 +32,7 @@ func Main(args []string) {
 	tlsKeyPath := cmd.String("tls-key", pkgK8s.MountPathTLSKeyPEM, "path to TLS Key PEM")
 	disableCommonNames := cmd.Bool("disable-common-names", false, "disable checks for Common Names (for development)")
 	trustDomain := cmd.String("identity-trust-domain", defaultDomain, "configures the name suffix used for identities")
+	auditEvents := cmd.Bool("audit-events", false, "emit a Kubernetes Event on every tapped resource")
 
 	traceCollector := flags.AddTraceFlags(cmd)
 
@@ -76,7 +77,9 @@ func Main(args []string) {
 		log.Fatal(err.Error())
 	}
 
-	apiServer, apiLis, err := tap.NewAPIServer(ctx, *apiServerAddr, cert, k8sAPI, grpcTapServer, *disableCommonNames)
+	auditor := tap.NewAuditor(k8sAPI, *auditEvents)
+
+	apiServer, apiLis, err := tap.NewAPIServer(ctx, *apiServerAddr, cert, k8sAPI, grpcTapServer, auditor, *disableCommonNames)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
@@ -0,0 +1,4 @@
+package identity
+
+// GroupName identifies the API Group Name for the identity resources.
+const GroupName = "identity.linkerd.io"
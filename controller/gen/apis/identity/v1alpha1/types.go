@@ -0,0 +1,49 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:noStatus
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IdentityDenyList describes mesh identities and certificates that must no
+// longer be trusted, e.g. because the workloads they were issued to have been
+// compromised
+type IdentityDenyList struct {
+	// TypeMeta is the metadata for the resource, like kind and apiversion
+	metav1.TypeMeta `json:",inline"`
+	// ObjectMeta contains the metadata for the particular object
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the custom resource spec
+	Spec IdentityDenyListSpec `json:"spec"`
+}
+
+// IdentityDenyListSpec specifies an IdentityDenyList resource.
+type IdentityDenyListSpec struct {
+	// Identities are the mesh identities that must not be issued certificates
+	// anymore, e.g. web.emojivoto.serviceaccount.identity.linkerd.cluster.local
+	Identities []string `json:"identities,omitempty"`
+
+	// SerialNumbers are the hex-encoded serial numbers of revoked
+	// certificates, e.g. 5c:0f:3e:... No certificate is issued whose chain
+	// includes one of them, e.g. a compromised issuer certificate
+	SerialNumbers []string `json:"serialNumbers,omitempty"`
+
+	// Reason describes why the identities and certificates were denied, for
+	// auditing purposes
+	Reason string `json:"reason,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IdentityDenyListList is a list of IdentityDenyList resources.
+type IdentityDenyListList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []IdentityDenyList `json:"items"`
+}
@@ -0,0 +1,4 @@
+package policy
+
+// GroupName identifies the API Group Name for the policy resources.
+const GroupName = "policy.linkerd.io"
@@ -0,0 +1,81 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServerAuthorization describes which clients may connect to some ports of the
+// pods it selects, in its namespace. Once a port is selected by any
+// ServerAuthorization, connections from clients that none of them authorize
+// are refused by the pods' proxies
+type ServerAuthorization struct {
+	// TypeMeta is the metadata for the resource, like kind and apiversion
+	metav1.TypeMeta `json:",inline"`
+	// ObjectMeta contains the metadata for the particular object
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the custom resource spec
+	Spec ServerAuthorizationSpec `json:"spec"`
+}
+
+// ServerAuthorizationSpec specifies a ServerAuthorization resource.
+type ServerAuthorizationSpec struct {
+	// PodSelector selects the pods the authorization applies to. An empty
+	// selector selects all the pods in the namespace
+	PodSelector metav1.LabelSelector `json:"podSelector"`
+
+	// Ports are the numbers or names of the container ports the authorization
+	// applies to. All ports are selected when empty
+	Ports []intstr.IntOrString `json:"ports,omitempty"`
+
+	// Client describes the clients that are authorized
+	Client Client `json:"client"`
+}
+
+// Client describes the clients authorized by a ServerAuthorization
+type Client struct {
+	// MeshTLS authorizes clients by their mesh identity
+	MeshTLS *MeshTLS `json:"meshTLS,omitempty"`
+
+	// Unauthenticated authorizes all clients, including the ones that don't
+	// have a mesh identity
+	Unauthenticated bool `json:"unauthenticated,omitempty"`
+}
+
+// MeshTLS describes the mesh identities of authorized clients
+type MeshTLS struct {
+	// Identities are the authorized identities, e.g.
+	// web.emojivoto.serviceaccount.identity.linkerd.cluster.local, or "*" to
+	// authorize all the identities of the mesh
+	Identities []string `json:"identities,omitempty"`
+
+	// ServiceAccounts are the service accounts whose identities are
+	// authorized. Service accounts without a namespace are looked up in the
+	// ServerAuthorization's namespace
+	ServiceAccounts []ServiceAccountName `json:"serviceAccounts,omitempty"`
+
+	// Namespaces are the namespaces whose service accounts' identities are
+	// all authorized
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// ServiceAccountName references a service account
+type ServiceAccountName struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServerAuthorizationList is a list of ServerAuthorization resources.
+type ServerAuthorizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ServerAuthorization `json:"items"`
+}
@@ -0,0 +1,4 @@
+package workload
+
+// GroupName identifies the API Group Name for the workload resources.
+const GroupName = "workload.linkerd.io"
@@ -0,0 +1,56 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ExternalWorkload describes a workload running outside of Kubernetes, e.g. on
+// a VM, that joins the mesh. Its labels are matched against the selectors of
+// the Services in its namespace, like a pod's
+type ExternalWorkload struct {
+	// TypeMeta is the metadata for the resource, like kind and apiversion
+	metav1.TypeMeta `json:",inline"`
+	// ObjectMeta contains the metadata for the particular object
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the custom resource spec
+	Spec ExternalWorkloadSpec `json:"spec"`
+}
+
+// ExternalWorkloadSpec specifies an ExternalWorkload resource.
+type ExternalWorkloadSpec struct {
+	// ServiceAccount is the name of the service account, in the workload's
+	// namespace, whose identity the workload is issued certificates for
+	ServiceAccount string `json:"serviceAccount"`
+
+	// WorkloadIPs are the IP addresses the workload is reachable at
+	WorkloadIPs []string `json:"workloadIPs,omitempty"`
+
+	// Ports are the ports the workload serves on, which Services can target by
+	// name
+	Ports []PortSpec `json:"ports,omitempty"`
+
+	// PublicKey is the PEM-encoded public key the workload proves its identity
+	// with when it doesn't use a join token
+	PublicKey string `json:"publicKey,omitempty"`
+}
+
+// PortSpec describes a port served by an ExternalWorkload
+type PortSpec struct {
+	Name string `json:"name,omitempty"`
+	Port int32  `json:"port"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ExternalWorkloadList is a list of ExternalWorkload resources.
+type ExternalWorkloadList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ExternalWorkload `json:"items"`
+}
@@ -19,7 +19,10 @@ limitations under the License.
 package scheme
 
 import (
+	identityv1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/identity/v1alpha1"
+	policyv1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/policy/v1alpha1"
 	linkerdv1alpha2 "github.com/linkerd/linkerd2/controller/gen/apis/serviceprofile/v1alpha2"
+	workloadv1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/workload/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -31,7 +34,10 @@ var Scheme = runtime.NewScheme()
 var Codecs = serializer.NewCodecFactory(Scheme)
 var ParameterCodec = runtime.NewParameterCodec(Scheme)
 var localSchemeBuilder = runtime.SchemeBuilder{
+	identityv1alpha1.AddToScheme,
+	policyv1alpha1.AddToScheme,
 	linkerdv1alpha2.AddToScheme,
+	workloadv1alpha1.AddToScheme,
 }
 
 // AddToScheme adds all types of this clientset into the given scheme. This allows composition
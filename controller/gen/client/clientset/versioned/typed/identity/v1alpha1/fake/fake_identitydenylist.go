@@ -0,0 +1,122 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/identity/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeIdentityDenyLists implements IdentityDenyListInterface
+type FakeIdentityDenyLists struct {
+	Fake *FakeIdentityV1alpha1
+}
+
+var identitydenylistsResource = schema.GroupVersionResource{Group: "identity.linkerd.io", Version: "v1alpha1", Resource: "identitydenylists"}
+
+var identitydenylistsKind = schema.GroupVersionKind{Group: "identity.linkerd.io", Version: "v1alpha1", Kind: "IdentityDenyList"}
+
+// Get takes name of the identityDenyList, and returns the corresponding identityDenyList object, and an error if there is any.
+func (c *FakeIdentityDenyLists) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.IdentityDenyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(identitydenylistsResource, name), &v1alpha1.IdentityDenyList{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.IdentityDenyList), err
+}
+
+// List takes label and field selectors, and returns the list of IdentityDenyLists that match those selectors.
+func (c *FakeIdentityDenyLists) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.IdentityDenyListList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(identitydenylistsResource, identitydenylistsKind, opts), &v1alpha1.IdentityDenyListList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.IdentityDenyListList{ListMeta: obj.(*v1alpha1.IdentityDenyListList).ListMeta}
+	for _, item := range obj.(*v1alpha1.IdentityDenyListList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested identityDenyLists.
+func (c *FakeIdentityDenyLists) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(identitydenylistsResource, opts))
+}
+
+// Create takes the representation of a identityDenyList and creates it.  Returns the server's representation of the identityDenyList, and an error, if there is any.
+func (c *FakeIdentityDenyLists) Create(ctx context.Context, identityDenyList *v1alpha1.IdentityDenyList, opts v1.CreateOptions) (result *v1alpha1.IdentityDenyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(identitydenylistsResource, identityDenyList), &v1alpha1.IdentityDenyList{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.IdentityDenyList), err
+}
+
+// Update takes the representation of a identityDenyList and updates it. Returns the server's representation of the identityDenyList, and an error, if there is any.
+func (c *FakeIdentityDenyLists) Update(ctx context.Context, identityDenyList *v1alpha1.IdentityDenyList, opts v1.UpdateOptions) (result *v1alpha1.IdentityDenyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(identitydenylistsResource, identityDenyList), &v1alpha1.IdentityDenyList{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.IdentityDenyList), err
+}
+
+// Delete takes name of the identityDenyList and deletes it. Returns an error if one occurs.
+func (c *FakeIdentityDenyLists) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(identitydenylistsResource, name), &v1alpha1.IdentityDenyList{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeIdentityDenyLists) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(identitydenylistsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.IdentityDenyListList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched identityDenyList.
+func (c *FakeIdentityDenyLists) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.IdentityDenyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(identitydenylistsResource, name, pt, data, subresources...), &v1alpha1.IdentityDenyList{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.IdentityDenyList), err
+}
@@ -0,0 +1,168 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/identity/v1alpha1"
+	scheme "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// IdentityDenyListsGetter has a method to return a IdentityDenyListInterface.
+// A group's client should implement this interface.
+type IdentityDenyListsGetter interface {
+	IdentityDenyLists() IdentityDenyListInterface
+}
+
+// IdentityDenyListInterface has methods to work with IdentityDenyList resources.
+type IdentityDenyListInterface interface {
+	Create(ctx context.Context, identityDenyList *v1alpha1.IdentityDenyList, opts v1.CreateOptions) (*v1alpha1.IdentityDenyList, error)
+	Update(ctx context.Context, identityDenyList *v1alpha1.IdentityDenyList, opts v1.UpdateOptions) (*v1alpha1.IdentityDenyList, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.IdentityDenyList, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.IdentityDenyListList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.IdentityDenyList, err error)
+	IdentityDenyListExpansion
+}
+
+// identityDenyLists implements IdentityDenyListInterface
+type identityDenyLists struct {
+	client rest.Interface
+}
+
+// newIdentityDenyLists returns a IdentityDenyLists
+func newIdentityDenyLists(c *IdentityV1alpha1Client) *identityDenyLists {
+	return &identityDenyLists{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the identityDenyList, and returns the corresponding identityDenyList object, and an error if there is any.
+func (c *identityDenyLists) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.IdentityDenyList, err error) {
+	result = &v1alpha1.IdentityDenyList{}
+	err = c.client.Get().
+		Resource("identitydenylists").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of IdentityDenyLists that match those selectors.
+func (c *identityDenyLists) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.IdentityDenyListList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.IdentityDenyListList{}
+	err = c.client.Get().
+		Resource("identitydenylists").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested identityDenyLists.
+func (c *identityDenyLists) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("identitydenylists").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a identityDenyList and creates it.  Returns the server's representation of the identityDenyList, and an error, if there is any.
+func (c *identityDenyLists) Create(ctx context.Context, identityDenyList *v1alpha1.IdentityDenyList, opts v1.CreateOptions) (result *v1alpha1.IdentityDenyList, err error) {
+	result = &v1alpha1.IdentityDenyList{}
+	err = c.client.Post().
+		Resource("identitydenylists").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(identityDenyList).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a identityDenyList and updates it. Returns the server's representation of the identityDenyList, and an error, if there is any.
+func (c *identityDenyLists) Update(ctx context.Context, identityDenyList *v1alpha1.IdentityDenyList, opts v1.UpdateOptions) (result *v1alpha1.IdentityDenyList, err error) {
+	result = &v1alpha1.IdentityDenyList{}
+	err = c.client.Put().
+		Resource("identitydenylists").
+		Name(identityDenyList.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(identityDenyList).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the identityDenyList and deletes it. Returns an error if one occurs.
+func (c *identityDenyLists) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("identitydenylists").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *identityDenyLists) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("identitydenylists").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched identityDenyList.
+func (c *identityDenyLists) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.IdentityDenyList, err error) {
+	result = &v1alpha1.IdentityDenyList{}
+	err = c.client.Patch(pt).
+		Resource("identitydenylists").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
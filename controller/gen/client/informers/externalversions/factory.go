@@ -24,8 +24,11 @@ import (
 	time "time"
 
 	versioned "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned"
+	identity "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/identity"
 	internalinterfaces "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/internalinterfaces"
+	policy "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/policy"
 	serviceprofile "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/serviceprofile"
+	workload "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/workload"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -172,9 +175,24 @@ type SharedInformerFactory interface {
 	ForResource(resource schema.GroupVersionResource) (GenericInformer, error)
 	WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool
 
+	Identity() identity.Interface
+	Policy() policy.Interface
 	Linkerd() serviceprofile.Interface
+	Workload() workload.Interface
+}
+
+func (f *sharedInformerFactory) Identity() identity.Interface {
+	return identity.New(f, f.namespace, f.tweakListOptions)
+}
+
+func (f *sharedInformerFactory) Policy() policy.Interface {
+	return policy.New(f, f.namespace, f.tweakListOptions)
 }
 
 func (f *sharedInformerFactory) Linkerd() serviceprofile.Interface {
 	return serviceprofile.New(f, f.namespace, f.tweakListOptions)
 }
+
+func (f *sharedInformerFactory) Workload() workload.Interface {
+	return workload.New(f, f.namespace, f.tweakListOptions)
+}
@@ -21,7 +21,10 @@ package externalversions
 import (
 	"fmt"
 
+	v1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/identity/v1alpha1"
+	policyv1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/policy/v1alpha1"
 	v1alpha2 "github.com/linkerd/linkerd2/controller/gen/apis/serviceprofile/v1alpha2"
+	workloadv1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/workload/v1alpha1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -52,10 +55,22 @@ func (f *genericInformer) Lister() cache.GenericLister {
 // TODO extend this to unknown resources with a client pool
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
-	// Group=linkerd.io, Version=v1alpha2
+	// Group=identity.linkerd.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("identitydenylists"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Identity().V1alpha1().IdentityDenyLists().Informer()}, nil
+
+		// Group=linkerd.io, Version=v1alpha2
 	case v1alpha2.SchemeGroupVersion.WithResource("serviceprofiles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Linkerd().V1alpha2().ServiceProfiles().Informer()}, nil
 
+		// Group=policy.linkerd.io, Version=v1alpha1
+	case policyv1alpha1.SchemeGroupVersion.WithResource("serverauthorizations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().ServerAuthorizations().Informer()}, nil
+
+		// Group=workload.linkerd.io, Version=v1alpha1
+	case workloadv1alpha1.SchemeGroupVersion.WithResource("externalworkloads"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Workload().V1alpha1().ExternalWorkloads().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
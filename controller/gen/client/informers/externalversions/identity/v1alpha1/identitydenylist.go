@@ -0,0 +1,89 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	identityv1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/identity/v1alpha1"
+	versioned "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned"
+	internalinterfaces "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/linkerd/linkerd2/controller/gen/client/listers/identity/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// IdentityDenyListInformer provides access to a shared informer and lister for
+// IdentityDenyLists.
+type IdentityDenyListInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.IdentityDenyListLister
+}
+
+type identityDenyListInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewIdentityDenyListInformer constructs a new informer for IdentityDenyList type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewIdentityDenyListInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredIdentityDenyListInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredIdentityDenyListInformer constructs a new informer for IdentityDenyList type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredIdentityDenyListInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.IdentityV1alpha1().IdentityDenyLists().List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.IdentityV1alpha1().IdentityDenyLists().Watch(context.TODO(), options)
+			},
+		},
+		&identityv1alpha1.IdentityDenyList{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *identityDenyListInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredIdentityDenyListInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *identityDenyListInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&identityv1alpha1.IdentityDenyList{}, f.defaultInformer)
+}
+
+func (f *identityDenyListInformer) Lister() v1alpha1.IdentityDenyListLister {
+	return v1alpha1.NewIdentityDenyListLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,68 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/identity/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// IdentityDenyListLister helps list IdentityDenyLists.
+// All objects returned here must be treated as read-only.
+type IdentityDenyListLister interface {
+	// List lists all IdentityDenyLists in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.IdentityDenyList, err error)
+	// Get retrieves the IdentityDenyList from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.IdentityDenyList, error)
+	IdentityDenyListListerExpansion
+}
+
+// identityDenyListLister implements the IdentityDenyListLister interface.
+type identityDenyListLister struct {
+	indexer cache.Indexer
+}
+
+// NewIdentityDenyListLister returns a new IdentityDenyListLister.
+func NewIdentityDenyListLister(indexer cache.Indexer) IdentityDenyListLister {
+	return &identityDenyListLister{indexer: indexer}
+}
+
+// List lists all IdentityDenyLists in the indexer.
+func (s *identityDenyListLister) List(selector labels.Selector) (ret []*v1alpha1.IdentityDenyList, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.IdentityDenyList))
+	})
+	return ret, err
+}
+
+// Get retrieves the IdentityDenyList from the index for a given name.
+func (s *identityDenyListLister) Get(name string) (*v1alpha1.IdentityDenyList, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("identitydenylist"), name)
+	}
+	return obj.(*v1alpha1.IdentityDenyList), nil
+}
@@ -0,0 +1,163 @@
+package healthchecker
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	eventReasonCheckFailed    = "HealthCheckFailed"
+	eventReasonCheckRecovered = "HealthCheckRecovered"
+)
+
+// DefaultCategories are the categories of checks that only read the state of
+// the cluster, and don't reach out to the Internet. The health-checker's RBAC
+// must allow all the API calls they make.
+var DefaultCategories = []healthcheck.CategoryID{
+	healthcheck.KubernetesAPIChecks,
+	healthcheck.LinkerdControlPlaneExistenceChecks,
+	healthcheck.LinkerdConfigChecks,
+	healthcheck.LinkerdIdentity,
+	healthcheck.LinkerdWebhooksAndAPISvcTLS,
+	healthcheck.LinkerdAPIChecks,
+	healthcheck.LinkerdDataPlaneChecks,
+}
+
+var (
+	checkLabels = []string{"category", "description", "warning", "hint_anchor"}
+
+	checkSuccess = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "health_check_success",
+		Help: "Whether a check passed (1) or failed (0) the last time its category was run.",
+	}, checkLabels)
+
+	lastRun = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "health_check_last_run_timestamp_seconds",
+		Help: "The time at which the checks were last run, in seconds since the Unix epoch.",
+	})
+)
+
+type checkKey struct {
+	category    healthcheck.CategoryID
+	description string
+}
+
+// Checker runs categories of health checks on a schedule, exporting their
+// results as Prometheus gauges and emitting Kubernetes Events on an object
+// when a check starts or stops failing.
+type Checker struct {
+	categories []healthcheck.CategoryID
+	options    *healthcheck.Options
+	recorder   record.EventRecorder
+	object     runtime.Object
+
+	// results holds the outcome of the checks of the last run
+	results map[checkKey]*healthcheck.CheckResult
+}
+
+// NewChecker returns a Checker running the given categories of checks.
+// Events are emitted on object, through recorder.
+func NewChecker(categories []healthcheck.CategoryID, options *healthcheck.Options, recorder record.EventRecorder, object runtime.Object) *Checker {
+	return &Checker{
+		categories: categories,
+		options:    options,
+		recorder:   recorder,
+		object:     object,
+		results:    map[checkKey]*healthcheck.CheckResult{},
+	}
+}
+
+// Run runs the checks immediately, and then at every interval until stop is
+// closed.
+func (c *Checker) Run(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.runChecks()
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (c *Checker) runChecks() {
+	log.Debugf("Running checks of categories %v", c.categories)
+
+	// A HealthChecker keeps the state gathered by its checks, so a new one is
+	// needed for every run
+	hc := healthcheck.NewHealthChecker(c.categories, c.options)
+	results := []*healthcheck.CheckResult{}
+	success := hc.RunChecks(func(result *healthcheck.CheckResult) {
+		results = append(results, result)
+	})
+	c.record(results)
+
+	log.Infof("Ran %d checks, success: %t", len(results), success)
+}
+
+// record exports the results of a run and emits events for the checks whose
+// outcome changed since the last run. Checks that didn't run this time, e.g.
+// because a fatal check failed before them, stop being exported.
+func (c *Checker) record(results []*healthcheck.CheckResult) {
+	current := map[checkKey]*healthcheck.CheckResult{}
+	for _, result := range results {
+		if result.Retry {
+			continue
+		}
+
+		key := checkKey{result.Category, checkDescription(result)}
+		current[key] = result
+
+		success := 1.0
+		if result.Err != nil {
+			success = 0
+		}
+		checkSuccess.With(checkResultLabels(result)).Set(success)
+
+		previous, ok := c.results[key]
+		switch {
+		case result.Err != nil && (!ok || previous.Err == nil):
+			c.recorder.Eventf(c.object, corev1.EventTypeWarning, eventReasonCheckFailed,
+				"[%s] %s: %s", key.category, key.description, result.Err)
+		case result.Err == nil && ok && previous.Err != nil:
+			c.recorder.Eventf(c.object, corev1.EventTypeNormal, eventReasonCheckRecovered,
+				"[%s] %s", key.category, key.description)
+		}
+	}
+
+	for key, previous := range c.results {
+		if _, ok := current[key]; !ok {
+			checkSuccess.Delete(checkResultLabels(previous))
+		}
+	}
+	c.results = current
+	lastRun.SetToCurrentTime()
+}
+
+func checkResultLabels(result *healthcheck.CheckResult) prometheus.Labels {
+	return prometheus.Labels{
+		"category":    string(result.Category),
+		"description": checkDescription(result),
+		"warning":     strconv.FormatBool(result.Warning),
+		"hint_anchor": result.HintAnchor,
+	}
+}
+
+// checkDescription strips the details verbose checks append to their
+// description, so that it identifies the check across runs.
+func checkDescription(result *healthcheck.CheckResult) string {
+	return strings.SplitN(result.Description, "\n", 2)[0]
+}
@@ -0,0 +1,74 @@
+package healthchecker
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestCheckerRecord(t *testing.T) {
+	const category = healthcheck.CategoryID("linkerd-identity")
+
+	passing := func(description string) *healthcheck.CheckResult {
+		return &healthcheck.CheckResult{Category: category, Description: description, HintAnchor: "l5d-anchor"}
+	}
+	failing := func(description string) *healthcheck.CheckResult {
+		result := passing(description)
+		result.Err = &healthcheck.CategoryError{Category: category, Err: errors.New("boom")}
+		return result
+	}
+
+	recorder := record.NewFakeRecorder(10)
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "linkerd-health-checker", Namespace: "linkerd"}}
+	checker := NewChecker([]healthcheck.CategoryID{category}, &healthcheck.Options{}, recorder, deploy)
+
+	for i, run := range []struct {
+		results []*healthcheck.CheckResult
+		gauges  map[string]float64
+		events  []string
+	}{
+		{
+			results: []*healthcheck.CheckResult{passing("issuer cert is valid"), failing("trust anchors are valid\nsome details")},
+			gauges:  map[string]float64{"issuer cert is valid": 1, "trust anchors are valid": 0},
+			events:  []string{"Warning HealthCheckFailed [linkerd-identity] trust anchors are valid: boom"},
+		},
+		{
+			// checks that keep failing don't emit events again
+			results: []*healthcheck.CheckResult{failing("issuer cert is valid"), failing("trust anchors are valid")},
+			gauges:  map[string]float64{"issuer cert is valid": 0, "trust anchors are valid": 0},
+			events:  []string{"Warning HealthCheckFailed [linkerd-identity] issuer cert is valid: boom"},
+		},
+		{
+			// retried results aren't final
+			results: []*healthcheck.CheckResult{{Category: category, Description: "issuer cert is valid", Retry: true}, passing("trust anchors are valid")},
+			gauges:  map[string]float64{"trust anchors are valid": 1},
+			events:  []string{"Normal HealthCheckRecovered [linkerd-identity] trust anchors are valid"},
+		},
+	} {
+		checker.record(run.results)
+
+		if count := testutil.CollectAndCount(checkSuccess); count != len(run.gauges) {
+			t.Fatalf("run %d: expected %d gauges, got %d", i, len(run.gauges), count)
+		}
+		for description, expected := range run.gauges {
+			gauge := checkSuccess.WithLabelValues(string(category), description, "false", "l5d-anchor")
+			if value := testutil.ToFloat64(gauge); value != expected {
+				t.Fatalf("run %d: expected gauge of %q to be %v, got %v", i, description, expected, value)
+			}
+		}
+
+		events := []string{}
+		for len(recorder.Events) > 0 {
+			events = append(events, <-recorder.Events)
+		}
+		if !reflect.DeepEqual(events, run.events) {
+			t.Fatalf("run %d: expected events %v, got %v", i, run.events, events)
+		}
+	}
+}
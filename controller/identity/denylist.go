@@ -0,0 +1,179 @@
+package identity
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	idl "github.com/linkerd/linkerd2/controller/gen/apis/identity/v1alpha1"
+	idllisters "github.com/linkerd/linkerd2/controller/gen/client/listers/identity/v1alpha1"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	logging "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/cache"
+)
+
+type (
+	// DenyList tracks the identities and certificate serial numbers denied by
+	// the IdentityDenyList resources in the cluster. Listeners can subscribe to
+	// be notified each time the set of denied identities changes.
+	DenyList struct {
+		lister     idllisters.IdentityDenyListLister
+		identities map[string]string // denied identity -> deny-list name
+		serials    map[string]string // denied serial number -> deny-list name
+		listeners  []DenyListListener
+
+		log          *logging.Entry
+		sync.RWMutex // This mutex protects the maps and listeners.
+	}
+
+	// DenyListListener is the interface that subscribers must implement.
+	DenyListListener interface {
+		DenyListUpdated()
+	}
+)
+
+// NewDenyList creates a DenyList and begins watching the k8sAPI for
+// IdentityDenyList changes.
+func NewDenyList(k8sAPI *k8s.API, log *logging.Entry) *DenyList {
+	dl := &DenyList{
+		lister:     k8sAPI.IDL().Lister(),
+		identities: make(map[string]string),
+		serials:    make(map[string]string),
+		log:        log.WithField("component", "identity-deny-list"),
+	}
+
+	k8sAPI.IDL().Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(interface{}) { dl.rebuild() },
+			UpdateFunc: func(interface{}, interface{}) { dl.rebuild() },
+			DeleteFunc: func(interface{}) { dl.rebuild() },
+		},
+	)
+
+	return dl
+}
+
+// DeniedIdentity returns the name of the deny-list denying the given identity,
+// if any.
+func (dl *DenyList) DeniedIdentity(identity string) (string, bool) {
+	dl.RLock()
+	defer dl.RUnlock()
+	name, ok := dl.identities[identity]
+	return name, ok
+}
+
+// DeniedSerialNumber returns the name of the deny-list denying the certificate
+// with the given serial number, if any.
+func (dl *DenyList) DeniedSerialNumber(serial *big.Int) (string, bool) {
+	if serial == nil {
+		return "", false
+	}
+	dl.RLock()
+	defer dl.RUnlock()
+	name, ok := dl.serials[serial.Text(16)]
+	return name, ok
+}
+
+// Subscribe registers a listener to be notified each time the deny-list
+// changes.
+func (dl *DenyList) Subscribe(listener DenyListListener) {
+	dl.Lock()
+	defer dl.Unlock()
+	dl.listeners = append(dl.listeners, listener)
+}
+
+// Unsubscribe removes a listener from the subscribers list.
+func (dl *DenyList) Unsubscribe(listener DenyListListener) {
+	dl.Lock()
+	defer dl.Unlock()
+	for i, l := range dl.listeners {
+		if l == listener {
+			n := len(dl.listeners)
+			dl.listeners[i] = dl.listeners[n-1]
+			dl.listeners[n-1] = nil
+			dl.listeners = dl.listeners[:n-1]
+			return
+		}
+	}
+}
+
+// rebuild recomputes the denied identities and serial numbers from all the
+// IdentityDenyLists in the informer's cache, and notifies the listeners.
+func (dl *DenyList) rebuild() {
+	lists, err := dl.lister.List(labels.Everything())
+	if err != nil {
+		dl.log.Errorf("Failed to list identity deny-lists: %s", err)
+		return
+	}
+
+	identities := make(map[string]string)
+	serials := make(map[string]string)
+	for _, list := range lists {
+		for _, id := range list.Spec.Identities {
+			identities[id] = list.Name
+		}
+		for _, s := range list.Spec.SerialNumbers {
+			serial, err := ParseSerialNumber(s)
+			if err != nil {
+				dl.log.Warnf("Ignoring serial number in identity deny-list %s: %s", list.Name, err)
+				continue
+			}
+			serials[serial.Text(16)] = list.Name
+		}
+	}
+
+	dl.Lock()
+	dl.identities = identities
+	dl.serials = serials
+	listeners := make([]DenyListListener, len(dl.listeners))
+	copy(listeners, dl.listeners)
+	dl.Unlock()
+
+	dl.log.Debugf("Updated identity deny-list: %d identities, %d serial numbers", len(identities), len(serials))
+	for _, listener := range listeners {
+		listener.DenyListUpdated()
+	}
+}
+
+// ParseSerialNumber parses a hex-encoded certificate serial number, as found
+// in an IdentityDenyList. Bytes may be separated by colons, and either case is
+// accepted.
+func ParseSerialNumber(s string) (*big.Int, error) {
+	hex := strings.ReplaceAll(strings.TrimSpace(s), ":", "")
+	serial, ok := new(big.Int).SetString(hex, 16)
+	if !ok || hex == "" || strings.HasPrefix(hex, "-") || strings.HasPrefix(hex, "+") {
+		return nil, fmt.Errorf("invalid serial number %q: expected a hex-encoded number", s)
+	}
+	return serial, nil
+}
+
+// ValidateDenyList returns an error describing the first invalid entry of the
+// given deny-list, if any.
+func ValidateDenyList(list *idl.IdentityDenyList) error {
+	if len(list.Spec.Identities) == 0 && len(list.Spec.SerialNumbers) == 0 {
+		return fmt.Errorf("%s denies neither identities nor serial numbers", list.Name)
+	}
+	for _, id := range list.Spec.Identities {
+		if err := validateIdentityName(id); err != nil {
+			return fmt.Errorf("%s: %s", list.Name, err)
+		}
+	}
+	for _, s := range list.Spec.SerialNumbers {
+		if _, err := ParseSerialNumber(s); err != nil {
+			return fmt.Errorf("%s: %s", list.Name, err)
+		}
+	}
+	return nil
+}
+
+// validateIdentityName checks that id is a DNS-like mesh identity, e.g.
+// web.emojivoto.serviceaccount.identity.linkerd.cluster.local
+func validateIdentityName(id string) error {
+	if errs := validation.IsDNS1123Subdomain(id); len(errs) > 0 {
+		return fmt.Errorf("invalid identity '%s': %s", id, errs[0])
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package identity
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	idl "github.com/linkerd/linkerd2/controller/gen/apis/identity/v1alpha1"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	logging "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type chanListener chan struct{}
+
+func (l chanListener) DenyListUpdated() {
+	l <- struct{}{}
+}
+
+func TestDenyList(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: identity.linkerd.io/v1alpha1
+kind: IdentityDenyList
+metadata:
+  name: compromised
+spec:
+  identities:
+  - web.emojivoto.serviceaccount.identity.linkerd.cluster.local
+  serialNumbers:
+  - 5C:0F:3E
+  - not-hex
+  reason: leaked key`,
+	)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+
+	dl := NewDenyList(k8sAPI, logging.WithField("test", t.Name()))
+	listener := make(chanListener, 1)
+	dl.Subscribe(listener)
+	k8sAPI.Sync(nil)
+
+	select {
+	case <-listener:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the listener to be notified")
+	}
+
+	if name, ok := dl.DeniedIdentity("web.emojivoto.serviceaccount.identity.linkerd.cluster.local"); !ok || name != "compromised" {
+		t.Fatalf("Expected the identity to be denied by compromised, got %q, %t", name, ok)
+	}
+	if _, ok := dl.DeniedIdentity("emoji.emojivoto.serviceaccount.identity.linkerd.cluster.local"); ok {
+		t.Fatal("Expected the identity not to be denied")
+	}
+	if _, ok := dl.DeniedSerialNumber(big.NewInt(0x5c0f3e)); !ok {
+		t.Fatal("Expected the serial number to be denied")
+	}
+	if _, ok := dl.DeniedSerialNumber(big.NewInt(1)); ok {
+		t.Fatal("Expected the serial number not to be denied")
+	}
+
+	dl.Unsubscribe(listener)
+	if len(dl.listeners) != 0 {
+		t.Fatalf("Expected no listeners, got %d", len(dl.listeners))
+	}
+}
+
+func TestValidateDenyList(t *testing.T) {
+	testCases := []struct {
+		spec  idl.IdentityDenyListSpec
+		valid bool
+	}{
+		{idl.IdentityDenyListSpec{Identities: []string{"web.emojivoto.serviceaccount.identity.linkerd.cluster.local"}}, true},
+		{idl.IdentityDenyListSpec{SerialNumbers: []string{"5c:0f:3e", "5C0F3E"}}, true},
+		{idl.IdentityDenyListSpec{}, false},
+		{idl.IdentityDenyListSpec{Identities: []string{"Web_Emojivoto"}}, false},
+		{idl.IdentityDenyListSpec{SerialNumbers: []string{"-5c"}}, false},
+		{idl.IdentityDenyListSpec{SerialNumbers: []string{"xyz"}}, false},
+	}
+
+	for i, tc := range testCases {
+		list := &idl.IdentityDenyList{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: tc.spec}
+		err := ValidateDenyList(list)
+		if tc.valid && err != nil {
+			t.Errorf("Test case %d: unexpected error: %s", i, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("Test case %d: expected an error", i)
+		}
+	}
+}
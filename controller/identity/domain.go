@@ -2,7 +2,10 @@ package identity
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
 
+	"github.com/linkerd/linkerd2/pkg/identity"
 	"k8s.io/apimachinery/pkg/util/validation"
 )
 
@@ -34,3 +37,30 @@ func (d *TrustDomain) Identity(typ, nm, ns string) (string, error) {
 	id := fmt.Sprintf("%s.%s.%s.identity.%s.%s", nm, ns, typ, d.controlNS, d.domain)
 	return id, nil
 }
+
+// SpiffeID formats the SPIFFE ID of a K8s service account, which is issued
+// alongside its DNS-form identity.
+func (d *TrustDomain) SpiffeID(sa, ns string) (*url.URL, error) {
+	for _, l := range []string{sa, ns} {
+		if errs := validation.IsDNS1123Label(l); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid label '%s': %s", l, errs[0])
+		}
+	}
+
+	return identity.NewSpiffeID(d.domain, ns, sa), nil
+}
+
+// IdentitySpiffeID returns the SPIFFE ID of a service account identity of the
+// trust domain, as formatted by Identity. An error is returned for other
+// identities, including those of other control planes.
+func (d *TrustDomain) IdentitySpiffeID(id string) (*url.URL, error) {
+	suffix := fmt.Sprintf(".serviceaccount.identity.%s.%s", d.controlNS, d.domain)
+	if !strings.HasSuffix(id, suffix) {
+		return nil, fmt.Errorf("not a service account identity of %s.%s: %s", d.controlNS, d.domain, id)
+	}
+	parts := strings.Split(strings.TrimSuffix(id, suffix), ".")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("not a service account identity of %s.%s: %s", d.controlNS, d.domain, id)
+	}
+	return d.SpiffeID(parts[0], parts[1])
+}
@@ -0,0 +1,55 @@
+package identity
+
+import (
+	"testing"
+)
+
+func TestTrustDomainSpiffeID(t *testing.T) {
+	dom, err := NewTrustDomain("linkerd", "cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	t.Run("Formats the SPIFFE ID of a service account", func(t *testing.T) {
+		spiffeID, err := dom.SpiffeID("web", "emojivoto")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if spiffeID.String() != "spiffe://cluster.local/ns/emojivoto/sa/web" {
+			t.Fatalf("Unexpected SPIFFE ID %s", spiffeID)
+		}
+		if _, err := dom.SpiffeID("web", "Emojivoto"); err == nil {
+			t.Fatal("Expected an error for an invalid namespace")
+		}
+	})
+
+	t.Run("Maps the identities of the trust domain to their SPIFFE ID", func(t *testing.T) {
+		for _, tc := range []struct {
+			identity string
+			expected string
+		}{
+			{"web.emojivoto.serviceaccount.identity.linkerd.cluster.local", "spiffe://cluster.local/ns/emojivoto/sa/web"},
+			{"web.emojivoto.serviceaccount.identity.linkerd.example.com", ""},
+			{"web.emojivoto.serviceaccount.identity.other.cluster.local", ""},
+			{"web.emojivoto.serviceaccount.identity.linkerd", ""},
+			{"linkerd-controller.linkerd.identity.linkerd.cluster.local", ""},
+			{"a.web.emojivoto.serviceaccount.identity.linkerd.cluster.local", ""},
+			{".serviceaccount.identity.linkerd.cluster.local", ""},
+			{"", ""},
+		} {
+			spiffeID, err := dom.IdentitySpiffeID(tc.identity)
+			if tc.expected == "" {
+				if err == nil {
+					t.Fatalf("Expected an error for %q, got %s", tc.identity, spiffeID)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error for %q: %s", tc.identity, err)
+			}
+			if spiffeID.String() != tc.expected {
+				t.Fatalf("Expected %s for %q, got %s", tc.expected, tc.identity, spiffeID)
+			}
+		}
+	})
+}
@@ -0,0 +1,243 @@
+package identity
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	ewlisters "github.com/linkerd/linkerd2/controller/gen/client/listers/workload/v1alpha1"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	"github.com/linkerd/linkerd2/pkg/identity"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	joinTokenPrefix      = "join"
+	challengeTokenPrefix = "key"
+
+	// DefaultChallengeWindow is how far apart the time a challenge token was
+	// signed and the time it's validated can be.
+	DefaultChallengeWindow = 5 * time.Minute
+)
+
+type (
+	// ExternalWorkloadValidator implements Validator for workloads running
+	// outside of Kubernetes, which are described by ExternalWorkload
+	// resources. Such workloads present either a join token, signed with a key
+	// shared with the identity controller, or a challenge token signed with the
+	// private key matching the public key registered in their ExternalWorkload.
+	// Any other token is handed to the fallback validator.
+	ExternalWorkloadValidator struct {
+		lister          ewlisters.ExternalWorkloadLister
+		domain          *TrustDomain
+		joinKey         []byte
+		challengeWindow time.Duration
+		fallback        identity.Validator
+		now             func() time.Time
+	}
+
+	// externalWorkloadClaims are the claims signed in external workload tokens.
+	// Challenge tokens are bound to the public key of the CSR they're sent
+	// with, so that they can't be replayed with another CSR.
+	externalWorkloadClaims struct {
+		Namespace string `json:"namespace"`
+		Name      string `json:"name"`
+		IssuedAt  int64  `json:"iat"`
+		ExpiresAt int64  `json:"exp,omitempty"`
+		CSRKey    string `json:"csr_key,omitempty"`
+	}
+)
+
+// NewExternalWorkloadValidator creates an ExternalWorkloadValidator looking up
+// ExternalWorkloads through the k8sAPI. Join tokens are only accepted if
+// joinKey isn't empty.
+func NewExternalWorkloadValidator(k8sAPI *k8s.API, domain *TrustDomain, joinKey []byte, fallback identity.Validator) *ExternalWorkloadValidator {
+	return &ExternalWorkloadValidator{
+		lister:          k8sAPI.EW().Lister(),
+		domain:          domain,
+		joinKey:         joinKey,
+		challengeWindow: DefaultChallengeWindow,
+		fallback:        fallback,
+		now:             time.Now,
+	}
+}
+
+// Validate accepts external workload tokens and returns the DNS-form linkerd ID
+// of the service account of the workload they were issued for.
+func (v *ExternalWorkloadValidator) Validate(ctx context.Context, tok []byte) (string, error) {
+	parts := strings.Split(string(tok), ".")
+	if len(parts) != 3 || (parts[0] != joinTokenPrefix && parts[0] != challengeTokenPrefix) {
+		return v.fallback.Validate(ctx, tok)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", identity.InvalidToken{Reason: fmt.Sprintf("invalid token payload: %s", err)}
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", identity.InvalidToken{Reason: fmt.Sprintf("invalid token signature: %s", err)}
+	}
+	var claims externalWorkloadClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", identity.InvalidToken{Reason: fmt.Sprintf("invalid token claims: %s", err)}
+	}
+	if claims.Namespace == "" || claims.Name == "" {
+		return "", identity.InvalidToken{Reason: "token must name an external workload"}
+	}
+
+	workload, err := v.lister.ExternalWorkloads(claims.Namespace).Get(claims.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", identity.NotAuthenticated{}
+		}
+		return "", err
+	}
+
+	signed := []byte(parts[0] + "." + parts[1])
+	now := v.now()
+	switch parts[0] {
+	case joinTokenPrefix:
+		if len(v.joinKey) == 0 || !hmac.Equal(sig, joinTokenMAC(v.joinKey, signed)) {
+			return "", identity.NotAuthenticated{}
+		}
+		if claims.ExpiresAt == 0 || now.After(time.Unix(claims.ExpiresAt, 0)) {
+			return "", identity.NotAuthenticated{}
+		}
+	case challengeTokenPrefix:
+		key, err := decodePublicKey(workload.Spec.PublicKey)
+		if err != nil {
+			return "", identity.NotAuthenticated{}
+		}
+		if err := verifySignature(key, signed, sig); err != nil {
+			return "", identity.NotAuthenticated{}
+		}
+		issuedAt := time.Unix(claims.IssuedAt, 0)
+		if issuedAt.Before(now.Add(-v.challengeWindow)) || issuedAt.After(now.Add(v.challengeWindow)) {
+			return "", identity.NotAuthenticated{}
+		}
+		csr, ok := identity.CSRFromContext(ctx)
+		if !ok || claims.CSRKey == "" || !hmac.Equal([]byte(claims.CSRKey), []byte(publicKeyHash(csr.RawSubjectPublicKeyInfo))) {
+			return "", identity.NotAuthenticated{}
+		}
+	}
+
+	return v.domain.Identity("serviceaccount", workload.Spec.ServiceAccount, workload.Namespace)
+}
+
+// NewJoinToken creates a join token for the given ExternalWorkload, signed with
+// the identity controller's join key and valid until expiresAt.
+func NewJoinToken(joinKey []byte, namespace, name string, expiresAt time.Time) (string, error) {
+	if len(joinKey) == 0 {
+		return "", errors.New("a join key is required")
+	}
+	signed, err := encodeClaims(joinTokenPrefix, externalWorkloadClaims{
+		Namespace: namespace,
+		Name:      name,
+		IssuedAt:  time.Now().Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(joinTokenMAC(joinKey, []byte(signed))), nil
+}
+
+// NewChallengeToken creates a challenge token for the given ExternalWorkload,
+// signed at issuedAt with the private key matching the public key registered in
+// the ExternalWorkload. The token is only valid along with a CSR for csrKey.
+func NewChallengeToken(key crypto.Signer, csrKey crypto.PublicKey, namespace, name string, issuedAt time.Time) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(csrKey)
+	if err != nil {
+		return "", err
+	}
+	signed, err := encodeClaims(challengeTokenPrefix, externalWorkloadClaims{
+		Namespace: namespace,
+		Name:      name,
+		IssuedAt:  issuedAt.Unix(),
+		CSRKey:    publicKeyHash(der),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var sig []byte
+	if _, ok := key.Public().(ed25519.PublicKey); ok {
+		sig, err = key.Sign(rand.Reader, []byte(signed), crypto.Hash(0))
+	} else {
+		digest := sha256.Sum256([]byte(signed))
+		sig, err = key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		return "", err
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+func encodeClaims(prefix string, claims externalWorkloadClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	return prefix + "." + base64.RawURLEncoding.EncodeToString(payload), nil
+}
+
+func joinTokenMAC(joinKey, signed []byte) []byte {
+	mac := hmac.New(sha256.New, joinKey)
+	mac.Write(signed)
+	return mac.Sum(nil)
+}
+
+// publicKeyHash returns the encoded SHA-256 digest of a DER-encoded
+// SubjectPublicKeyInfo.
+func publicKeyHash(der []byte) string {
+	digest := sha256.Sum256(der)
+	return base64.RawURLEncoding.EncodeToString(digest[:])
+}
+
+func decodePublicKey(txt string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(txt))
+	if block == nil {
+		return nil, errors.New("not a PEM-encoded public key")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+func verifySignature(key crypto.PublicKey, signed, sig []byte) error {
+	digest := sha256.Sum256(signed)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		var esig struct{ R, S *big.Int }
+		if _, err := asn1.Unmarshal(sig, &esig); err != nil {
+			return err
+		}
+		if !ecdsa.Verify(k, digest[:], esig.R, esig.S) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, signed, sig) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+}
@@ -0,0 +1,128 @@
+package identity
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	"github.com/linkerd/linkerd2/pkg/identity"
+	"github.com/linkerd/linkerd2/pkg/tls"
+)
+
+type staticValidator string
+
+func (v staticValidator) Validate(context.Context, []byte) (string, error) {
+	return string(v), nil
+}
+
+// newTestCSR returns a CSR for name, with a fresh key.
+func newTestCSR(t *testing.T, name string) *x509.CertificateRequest {
+	t.Helper()
+	key, err := tls.GenerateKey()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: name},
+		DNSNames: []string{name},
+	}, key)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return csr
+}
+
+func TestExternalWorkloadValidator(t *testing.T) {
+	key, err := tls.GenerateKey()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	publicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	k8sAPI, err := k8s.NewFakeAPI(fmt.Sprintf(`
+apiVersion: workload.linkerd.io/v1alpha1
+kind: ExternalWorkload
+metadata:
+  name: vm-1
+  namespace: emojivoto
+spec:
+  serviceAccount: legacy-billing
+  workloadIPs:
+  - 10.10.0.1
+  publicKey: |
+    %s`, strings.ReplaceAll(strings.TrimSpace(string(publicKey)), "\n", "\n    ")),
+	)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+	k8sAPI.Sync(nil)
+
+	dom, err := NewTrustDomain("linkerd", "cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	joinKey := []byte("0123456789abcdef0123456789abcdef")
+	v := NewExternalWorkloadValidator(k8sAPI, dom, joinKey, staticValidator("fallback"))
+
+	expected := "legacy-billing.emojivoto.serviceaccount.identity.linkerd.cluster.local"
+	now := time.Now()
+
+	valid := func(tok string, err error) string {
+		if err != nil {
+			t.Fatalf("Unexpected error creating token: %s", err)
+		}
+		return tok
+	}
+	otherKey, err := tls.GenerateKey()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	csr := newTestCSR(t, expected)
+	otherCSR := newTestCSR(t, expected)
+
+	testCases := []struct {
+		name     string
+		token    string
+		identity string
+		err      error
+	}{
+		{"join token", valid(NewJoinToken(joinKey, "emojivoto", "vm-1", now.Add(time.Hour))), expected, nil},
+		{"expired join token", valid(NewJoinToken(joinKey, "emojivoto", "vm-1", now.Add(-time.Minute))), "", identity.NotAuthenticated{}},
+		{"join token signed with another key", valid(NewJoinToken([]byte("another-key"), "emojivoto", "vm-1", now.Add(time.Hour))), "", identity.NotAuthenticated{}},
+		{"join token for unknown workload", valid(NewJoinToken(joinKey, "emojivoto", "vm-2", now.Add(time.Hour))), "", identity.NotAuthenticated{}},
+		{"challenge token", valid(NewChallengeToken(key, csr.PublicKey, "emojivoto", "vm-1", now)), expected, nil},
+		{"stale challenge token", valid(NewChallengeToken(key, csr.PublicKey, "emojivoto", "vm-1", now.Add(-time.Hour))), "", identity.NotAuthenticated{}},
+		{"challenge token signed with another key", valid(NewChallengeToken(otherKey, csr.PublicKey, "emojivoto", "vm-1", now)), "", identity.NotAuthenticated{}},
+		{"challenge token replayed with another CSR", valid(NewChallengeToken(key, otherCSR.PublicKey, "emojivoto", "vm-1", now)), "", identity.NotAuthenticated{}},
+		{"malformed token", "join.!!!.!!!", "", identity.InvalidToken{Reason: "invalid token payload: illegal base64 data at input byte 0"}},
+		{"kubernetes token", "eyJhbGciOiJSUzI1NiJ9.eyJzdWIiOiJ3ZWIifQ.c2ln", "fallback", nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := v.Validate(identity.WithCSR(context.Background(), csr), []byte(tc.token))
+			if err != tc.err {
+				t.Fatalf("Expected error %v, got %v", tc.err, err)
+			}
+			if id != tc.identity {
+				t.Fatalf("Expected identity %q, got %q", tc.identity, id)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/linkerd/linkerd2/pkg/identity"
+	log "github.com/sirupsen/logrus"
 	kauthnApi "k8s.io/api/authentication/v1"
 	kauthzApi "k8s.io/api/authorization/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,13 +18,21 @@ import (
 
 // K8sTokenValidator implements Validator for Kubernetes bearer tokens.
 type K8sTokenValidator struct {
-	authn  kauthn.AuthenticationV1Interface
-	domain *TrustDomain
+	authn       kauthn.AuthenticationV1Interface
+	domain      *TrustDomain
+	audience    string
+	allowLegacy bool
 }
 
 // NewK8sTokenValidator takes a kubernetes client and trust domain to create a
 // K8sTokenValidator.
 //
+// When audience is set, only tokens bound to that audience are accepted,
+// unless allowLegacy is set, in which case tokens that are valid for the
+// kubernetes API server's default audience are accepted too. This allows
+// workloads injected before projected tokens were introduced to keep
+// obtaining certificates until they are re-injected.
+//
 // The kubernetes client is used immediately to validate that the client has
 // sufficient privileges to perform token reviews. An error is returned if this
 // access check fails.
@@ -31,23 +40,30 @@ func NewK8sTokenValidator(
 	ctx context.Context,
 	k8s k8s.Interface,
 	domain *TrustDomain,
+	audience string,
+	allowLegacy bool,
 ) (identity.Validator, error) {
 	if err := checkAccess(ctx, k8s.AuthorizationV1()); err != nil {
 		return nil, err
 	}
 
 	authn := k8s.AuthenticationV1()
-	return &K8sTokenValidator{authn, domain}, nil
+	return &K8sTokenValidator{authn, domain, audience, allowLegacy}, nil
 }
 
 // Validate accepts kubernetes bearer tokens and returns a DNS-form linkerd ID.
 func (k *K8sTokenValidator) Validate(ctx context.Context, tok []byte) (string, error) {
-	// TODO: Set/check `audience`
-	tr := kauthnApi.TokenReview{Spec: kauthnApi.TokenReviewSpec{Token: string(tok)}}
-	rvw, err := k.authn.TokenReviews().Create(ctx, &tr, metav1.CreateOptions{})
+	rvw, err := k.review(ctx, tok, k.audience)
 	if err != nil {
 		return "", err
 	}
+	if k.audience != "" && k.allowLegacy && !reviewAuthenticated(rvw) {
+		log.Debugf("Token not valid for audience %s, falling back to legacy token review", k.audience)
+		rvw, err = k.review(ctx, tok, "")
+		if err != nil {
+			return "", err
+		}
+	}
 
 	if rvw.Status.Error != "" {
 		return "", identity.InvalidToken{Reason: rvw.Status.Error}
@@ -55,6 +71,10 @@ func (k *K8sTokenValidator) Validate(ctx context.Context, tok []byte) (string, e
 	if !rvw.Status.Authenticated {
 		return "", identity.NotAuthenticated{}
 	}
+	if aud := rvw.Spec.Audiences; len(aud) > 0 && !hasAudience(rvw.Status.Audiences, aud[0]) {
+		msg := fmt.Sprintf("Token is not bound to audience %s", aud[0])
+		return "", identity.InvalidToken{Reason: msg}
+	}
 
 	// Determine the identity associated with the token's userinfo.
 	uns := strings.Split(rvw.Status.User.Username, ":")
@@ -72,6 +92,29 @@ func (k *K8sTokenValidator) Validate(ctx context.Context, tok []byte) (string, e
 	return k.domain.Identity(uns[0], uns[2], uns[1])
 }
 
+// review submits a TokenReview for the token, requiring it to be bound to
+// the given audience if set.
+func (k *K8sTokenValidator) review(ctx context.Context, tok []byte, audience string) (*kauthnApi.TokenReview, error) {
+	tr := kauthnApi.TokenReview{Spec: kauthnApi.TokenReviewSpec{Token: string(tok)}}
+	if audience != "" {
+		tr.Spec.Audiences = []string{audience}
+	}
+	return k.authn.TokenReviews().Create(ctx, &tr, metav1.CreateOptions{})
+}
+
+func reviewAuthenticated(rvw *kauthnApi.TokenReview) bool {
+	return rvw.Status.Error == "" && rvw.Status.Authenticated
+}
+
+func hasAudience(audiences []string, audience string) bool {
+	for _, a := range audiences {
+		if a == audience {
+			return true
+		}
+	}
+	return false
+}
+
 func checkAccess(ctx context.Context, authz kauthz.AuthorizationV1Interface) error {
 	r := &kauthzApi.SelfSubjectAccessReview{
 		Spec: kauthzApi.SelfSubjectAccessReviewSpec{
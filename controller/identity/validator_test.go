@@ -0,0 +1,105 @@
+package identity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linkerd/linkerd2/pkg/identity"
+	kauthnApi "k8s.io/api/authentication/v1"
+	kauthzApi "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const (
+	legacyToken    = "legacy"
+	projectedToken = "projected"
+	testAudience   = "identity.l5d.io"
+)
+
+// fakeTokenReviewer authenticates the legacy token for the API server's
+// default audience only, and the projected token for testAudience only.
+func fakeTokenReviewer(action k8stesting.Action) (bool, runtime.Object, error) {
+	tr := action.(k8stesting.CreateAction).GetObject().(*kauthnApi.TokenReview).DeepCopy()
+
+	var audiences []string
+	switch tr.Spec.Token {
+	case legacyToken:
+		audiences = []string{"https://kubernetes.default.svc"}
+	case projectedToken:
+		audiences = []string{testAudience}
+	}
+	if len(tr.Spec.Audiences) > 0 {
+		if !hasAudience(audiences, tr.Spec.Audiences[0]) {
+			tr.Status.Error = "token audiences are invalid"
+			return true, tr, nil
+		}
+		audiences = tr.Spec.Audiences
+	}
+
+	if len(audiences) > 0 {
+		tr.Status.Authenticated = true
+		tr.Status.Audiences = audiences
+		tr.Status.User.Username = "system:serviceaccount:emojivoto:web"
+	}
+	return true, tr, nil
+}
+
+func newFakeValidator(t *testing.T, audience string, allowLegacy bool) identity.Validator {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &kauthzApi.SelfSubjectAccessReview{Status: kauthzApi.SubjectAccessReviewStatus{Allowed: true}}, nil
+	})
+	client.PrependReactor("create", "tokenreviews", fakeTokenReviewer)
+
+	dom, err := NewTrustDomain("linkerd", "cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	v, err := NewK8sTokenValidator(context.Background(), client, dom, audience, allowLegacy)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return v
+}
+
+func TestK8sTokenValidator(t *testing.T) {
+	expectedID := "web.emojivoto.serviceaccount.identity.linkerd.cluster.local"
+
+	testCases := []struct {
+		name        string
+		audience    string
+		allowLegacy bool
+		token       string
+		valid       bool
+	}{
+		{"no audience accepts legacy tokens", "", false, legacyToken, true},
+		{"audience accepts bound tokens", testAudience, false, projectedToken, true},
+		{"audience rejects legacy tokens", testAudience, false, legacyToken, false},
+		{"audience with legacy fallback accepts legacy tokens", testAudience, true, legacyToken, true},
+		{"audience with legacy fallback accepts bound tokens", testAudience, true, projectedToken, true},
+		{"audience rejects tokens bound to another audience", "other", false, projectedToken, false},
+		{"unknown tokens are rejected", testAudience, true, "bogus", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			v := newFakeValidator(t, tc.audience, tc.allowLegacy)
+			id, err := v.Validate(context.Background(), []byte(tc.token))
+			if !tc.valid {
+				if err == nil {
+					t.Fatalf("Expected token to be rejected, got identity %s", id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if id != expectedID {
+				t.Fatalf("Expected identity %s, got %s", expectedID, id)
+			}
+		})
+	}
+}
@@ -11,7 +11,10 @@ import (
 	spv1alpha2 "github.com/linkerd/linkerd2/controller/gen/apis/serviceprofile/v1alpha2"
 	spclient "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned"
 	sp "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions"
+	idlinformers "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/identity/v1alpha1"
+	authzinformers "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/policy/v1alpha1"
 	spinformers "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/serviceprofile/v1alpha2"
+	ewinformers "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/workload/v1alpha1"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	tsclient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
 	ts "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/informers/externalversions"
@@ -62,7 +65,10 @@ const (
 	TS
 	Node
 	Secret
-	ES // EndpointSlice resource
+	ES    // EndpointSlice resource
+	IDL   // IdentityDenyList resource
+	EW    // ExternalWorkload resource
+	AUTHZ // ServerAuthorization resource
 )
 
 // API provides shared informers for all Kubernetes objects
@@ -75,6 +81,9 @@ type API struct {
 	ds       appv1informers.DaemonSetInformer
 	endpoint coreinformers.EndpointsInformer
 	es       discoveryinformers.EndpointSliceInformer
+	idl      idlinformers.IdentityDenyListInformer
+	ew       ewinformers.ExternalWorkloadInformer
+	authz    authzinformers.ServerAuthorizationInformer
 	job      batchv1informers.JobInformer
 	mwc      arinformers.MutatingWebhookConfigurationInformer
 	ns       coreinformers.NamespaceInformer
@@ -139,6 +148,11 @@ func initAPI(ctx context.Context, k8sClient *k8s.KubernetesAPI, kubeConfig *rest
 				return nil, err
 			}
 
+			break
+		}
+	}
+	for _, res := range resources {
+		if res == SP || res == IDL || res == EW || res == AUTHZ {
 			spClient, err = NewSpClientSet(kubeConfig)
 			if err != nil {
 				return nil, err
@@ -207,6 +221,15 @@ func NewAPI(
 		case Endpoint:
 			api.endpoint = sharedInformers.Core().V1().Endpoints()
 			api.syncChecks = append(api.syncChecks, api.endpoint.Informer().HasSynced)
+		case IDL:
+			api.idl = spSharedInformers.Identity().V1alpha1().IdentityDenyLists()
+			api.syncChecks = append(api.syncChecks, api.idl.Informer().HasSynced)
+		case EW:
+			api.ew = spSharedInformers.Workload().V1alpha1().ExternalWorkloads()
+			api.syncChecks = append(api.syncChecks, api.ew.Informer().HasSynced)
+		case AUTHZ:
+			api.authz = spSharedInformers.Policy().V1alpha1().ServerAuthorizations()
+			api.syncChecks = append(api.syncChecks, api.authz.Informer().HasSynced)
 		case ES:
 			api.es = sharedInformers.Discovery().V1beta1().EndpointSlices()
 			api.syncChecks = append(api.syncChecks, api.es.Informer().HasSynced)
@@ -386,6 +409,49 @@ func (api *API) SPAvailable() bool {
 	return api.sp != nil
 }
 
+// IDL provides access to a shared informer and lister for IdentityDenyLists.
+func (api *API) IDL() idlinformers.IdentityDenyListInformer {
+	if api.idl == nil {
+		panic("IDL informer not configured")
+	}
+	return api.idl
+}
+
+// IDLAvailable informs the caller whether this API is configured to retrieve
+// IdentityDenyLists
+func (api *API) IDLAvailable() bool {
+	return api.idl != nil
+}
+
+// EW provides access to a shared informer and lister for ExternalWorkloads.
+func (api *API) EW() ewinformers.ExternalWorkloadInformer {
+	if api.ew == nil {
+		panic("EW informer not configured")
+	}
+	return api.ew
+}
+
+// EWAvailable informs the caller whether this API is configured to retrieve
+// ExternalWorkloads
+func (api *API) EWAvailable() bool {
+	return api.ew != nil
+}
+
+// AUTHZ provides access to a shared informer and lister for
+// ServerAuthorizations.
+func (api *API) AUTHZ() authzinformers.ServerAuthorizationInformer {
+	if api.authz == nil {
+		panic("AUTHZ informer not configured")
+	}
+	return api.authz
+}
+
+// AUTHZAvailable informs the caller whether this API is configured to retrieve
+// ServerAuthorizations
+func (api *API) AUTHZAvailable() bool {
+	return api.authz != nil
+}
+
 // TS provides access to a shared informer and lister for TrafficSplits.
 func (api *API) TS() tsinformers.TrafficSplitInformer {
 	if api.ts == nil {
@@ -33,6 +33,9 @@ func NewFakeAPI(configs ...string) (*API, error) {
 		TS,
 		Node,
 		ES,
+		IDL,
+		EW,
+		AUTHZ,
 	), nil
 }
 
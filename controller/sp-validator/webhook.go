@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/linkerd/linkerd2/controller/k8s"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/policy"
 	"github.com/linkerd/linkerd2/pkg/profiles"
 	admissionv1beta1 "k8s.io/api/admission/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -11,12 +13,17 @@ import (
 )
 
 // AdmitSP verifies that the received Admission Request contains a valid
-// Service Profile definition
+// Service Profile definition, or a valid ServerAuthorization
 func AdmitSP(
 	_ context.Context, _ *k8s.API, request *admissionv1beta1.AdmissionRequest, _ record.EventRecorder,
 ) (*admissionv1beta1.AdmissionResponse, error) {
+	validate := profiles.Validate
+	if request.Kind.Kind == pkgK8s.ServerAuthorizationKind {
+		validate = policy.Validate
+	}
+
 	admissionResponse := &admissionv1beta1.AdmissionResponse{Allowed: true}
-	if err := profiles.Validate(request.Object.Raw); err != nil {
+	if err := validate(request.Object.Raw); err != nil {
 		admissionResponse.Allowed = false
 		admissionResponse.Result = &metav1.Status{Message: err.Error(), Code: 400}
 	}
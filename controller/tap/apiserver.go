@@ -31,6 +31,7 @@ func NewAPIServer(
 	cert tls.Certificate,
 	k8sAPI *k8s.API,
 	grpcTapServer tap.TapServer,
+	auditor *Auditor,
 	disableCommonNames bool,
 ) (*http.Server, net.Listener, error) {
 	clientCAPem, allowedNames, usernameHeader, groupHeader, err := apiServerAuth(ctx, k8sAPI)
@@ -53,6 +54,7 @@ func NewAPIServer(
 		usernameHeader: usernameHeader,
 		groupHeader:    groupHeader,
 		grpcTapServer:  grpcTapServer,
+		auditor:        auditor,
 		log:            log,
 	}
 
@@ -0,0 +1,157 @@
+package tap
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	auditComponent = "linkerd-tap"
+
+	auditOutcomeAllowed = "allowed"
+	auditOutcomeDenied  = "denied"
+	auditOutcomeFailed  = "failed"
+
+	eventReasonTapped = "Tapped"
+)
+
+var tapSessions = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "tap_sessions_total",
+		Help: "A counter for the number of tap sessions served by the tap APIServer.",
+	},
+	[]string{"namespace", "resource", "extract", "outcome"},
+)
+
+// auditRecord describes a single tap session served by the APIServer.
+type auditRecord struct {
+	user      string
+	groups    []string
+	namespace string
+	resource  string
+	name      string
+	match     string
+	extract   bool
+	outcome   string
+	duration  time.Duration
+	events    int
+	err       error
+}
+
+// Auditor keeps a record of who tapped what. Every tap session is logged to
+// a dedicated structured logger and counted in tap_sessions_total. If
+// configured with an event recorder, a Kubernetes Event is also emitted on
+// the tapped resource.
+type Auditor struct {
+	k8sAPI   *k8s.API
+	log      *logrus.Logger
+	recorder record.EventRecorder
+}
+
+// NewAuditor returns an Auditor writing JSON records to stdout. If
+// recordEvents is true, Kubernetes Events are emitted on tapped resources.
+func NewAuditor(k8sAPI *k8s.API, recordEvents bool) *Auditor {
+	auditLog := logrus.New()
+	auditLog.SetOutput(os.Stdout)
+	auditLog.SetFormatter(&logrus.JSONFormatter{})
+
+	var recorder record.EventRecorder
+	if recordEvents {
+		eventBroadcaster := record.NewBroadcaster()
+		eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+			// In order to send events to all namespaces, we need to use an empty string here
+			// re: client-go's event_expansion.go CreateWithEventNamespace()
+			Interface: k8sAPI.Client.CoreV1().Events(""),
+		})
+		recorder = eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: auditComponent})
+	}
+
+	return &Auditor{
+		k8sAPI:   k8sAPI,
+		log:      auditLog,
+		recorder: recorder,
+	}
+}
+
+// setRequest fills in the details of the decoded tap request. Until then the
+// record only holds what could be parsed from the request path.
+func (r *auditRecord) setRequest(req *public.TapByResourceRequest) {
+	res := req.GetTarget().GetResource()
+	r.namespace = res.GetNamespace()
+	r.resource = res.GetType()
+	r.name = res.GetName()
+	if req.GetMatch() != nil {
+		r.match = req.GetMatch().String()
+	}
+	r.extract = req.GetExtract().GetHttp() != nil
+}
+
+func (a *Auditor) record(r *auditRecord) {
+	tapSessions.With(prometheus.Labels{
+		"namespace": r.namespace,
+		"resource":  r.resource,
+		"extract":   strconv.FormatBool(r.extract),
+		"outcome":   r.outcome,
+	}).Inc()
+
+	entry := a.log.WithFields(logrus.Fields{
+		"component": "tap-audit",
+		"user":      r.user,
+		"groups":    r.groups,
+		"namespace": r.namespace,
+		"resource":  r.resource,
+		"name":      r.name,
+		"match":     r.match,
+		"extract":   r.extract,
+		"outcome":   r.outcome,
+		"duration":  r.duration.String(),
+		"events":    r.events,
+	})
+	if r.err != nil {
+		entry = entry.WithError(r.err)
+	}
+	entry.Info("tap session")
+
+	if a.recorder == nil || r.outcome == auditOutcomeDenied {
+		return
+	}
+	obj, err := a.tappedObject(r)
+	if err != nil {
+		a.log.Debugf("not recording tap event for %s/%s: %s", r.resource, r.name, err)
+		return
+	}
+	a.recorder.Eventf(obj, corev1.EventTypeNormal, eventReasonTapped,
+		"Tapped by %s for %s (%d events, headers extracted: %t)",
+		r.user, r.duration.Round(time.Second), r.events, r.extract)
+}
+
+// tappedObject looks up the resource targeted by a tap session, so that an
+// event can be attached to it.
+func (a *Auditor) tappedObject(r *auditRecord) (runtime.Object, error) {
+	if r.name == "" {
+		return nil, errors.New("tap target does not name a single resource")
+	}
+	objects, err := a.k8sAPI.GetObjects(r.namespace, r.resource, r.name, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("%s/%s not found", r.resource, r.name)
+	}
+	return objects[0], nil
+}
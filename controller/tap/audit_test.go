@@ -0,0 +1,136 @@
+package tap
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestAuditorRecord(t *testing.T) {
+	deploy := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: emoji
+  namespace: emojivoto
+`
+
+	expectations := []struct {
+		record *auditRecord
+		events []string
+	}{
+		{
+			record: &auditRecord{
+				user:      "alice",
+				groups:    []string{"system:authenticated"},
+				namespace: "emojivoto",
+				resource:  pkgK8s.Deployment,
+				name:      "emoji",
+				extract:   true,
+				outcome:   auditOutcomeAllowed,
+				duration:  3 * time.Second,
+				events:    12,
+			},
+			events: []string{"Normal Tapped Tapped by alice for 3s (12 events, headers extracted: true)"},
+		},
+		{
+			record: &auditRecord{
+				user:      "bob",
+				namespace: "emojivoto",
+				resource:  "deployments",
+				name:      "emoji",
+				outcome:   auditOutcomeDenied,
+				err:       errors.New("not authorized"),
+			},
+		},
+		{
+			record: &auditRecord{
+				user:      "carol",
+				namespace: "emojivoto",
+				resource:  pkgK8s.Deployment,
+				name:      "missing",
+				outcome:   auditOutcomeAllowed,
+			},
+		},
+	}
+
+	for i, exp := range expectations {
+		exp := exp // pin
+
+		t.Run(fmt.Sprintf("%d records the tap session", i), func(t *testing.T) {
+			k8sAPI, err := k8s.NewFakeAPI(deploy)
+			if err != nil {
+				t.Fatalf("NewFakeAPI returned an error: %s", err)
+			}
+			k8sAPI.Sync(nil)
+
+			recorder := record.NewFakeRecorder(10)
+			auditor := NewAuditor(k8sAPI, false)
+			auditor.recorder = recorder
+
+			counter := tapSessions.With(prometheus.Labels{
+				"namespace": exp.record.namespace,
+				"resource":  exp.record.resource,
+				"extract":   fmt.Sprintf("%t", exp.record.extract),
+				"outcome":   exp.record.outcome,
+			})
+			before := testutil.ToFloat64(counter)
+
+			auditor.record(exp.record)
+
+			if after := testutil.ToFloat64(counter); after != before+1 {
+				t.Errorf("Expected tap_sessions_total to be incremented, got %v -> %v", before, after)
+			}
+
+			close(recorder.Events)
+			events := []string{}
+			for e := range recorder.Events {
+				events = append(events, e)
+			}
+			if len(events) != len(exp.events) {
+				t.Fatalf("Expected events %v, got %v", exp.events, events)
+			}
+			for j := range events {
+				if events[j] != exp.events[j] {
+					t.Errorf("Expected event %q, got %q", exp.events[j], events[j])
+				}
+			}
+		})
+	}
+}
+
+func TestAuditRecordSetRequest(t *testing.T) {
+	r := &auditRecord{namespace: "emojivoto", resource: "deployments", name: "emoji"}
+	r.setRequest(&public.TapByResourceRequest{
+		Target: &public.ResourceSelection{
+			Resource: &public.Resource{
+				Namespace: "emojivoto",
+				Type:      pkgK8s.Deployment,
+				Name:      "emoji",
+			},
+		},
+		Extract: &public.TapByResourceRequest_Extract{
+			Extract: &public.TapByResourceRequest_Extract_Http_{
+				Http: &public.TapByResourceRequest_Extract_Http{},
+			},
+		},
+	})
+
+	if r.resource != pkgK8s.Deployment {
+		t.Errorf("Expected resource %q, got %q", pkgK8s.Deployment, r.resource)
+	}
+	if !r.extract {
+		t.Error("Expected extract to be set")
+	}
+	if r.match != "" {
+		t.Errorf("Expected empty match, got %q", r.match)
+	}
+}
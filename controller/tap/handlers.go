@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/spec"
 	"github.com/julienschmidt/httprouter"
@@ -28,6 +29,7 @@ type handler struct {
 	usernameHeader string
 	groupHeader    string
 	grpcTapServer  pb.TapServer
+	auditor        *Auditor
 	log            *logrus.Entry
 }
 
@@ -125,6 +127,20 @@ func (h *handler) handleTap(w http.ResponseWriter, req *http.Request, p httprout
 		namespace, resource, name, req.Header.Get(h.usernameHeader), req.Header[h.groupHeader],
 	)
 
+	start := time.Now()
+	audit := &auditRecord{
+		user:      req.Header.Get(h.usernameHeader),
+		groups:    req.Header[h.groupHeader],
+		namespace: namespace,
+		resource:  resource,
+		name:      name,
+		outcome:   auditOutcomeFailed,
+	}
+	defer func() {
+		audit.duration = time.Since(start)
+		h.auditor.record(audit)
+	}()
+
 	// TODO: it's possible this SubjectAccessReview is redundant, consider
 	// removing, more info at https://github.com/linkerd/linkerd2/issues/3182
 	err := pkgK8s.ResourceAuthzForUser(
@@ -142,6 +158,8 @@ func (h *handler) handleTap(w http.ResponseWriter, req *http.Request, p httprout
 	)
 	if err != nil {
 		err = fmt.Errorf("tap authorization failed (%s), visit %s for more information", err, tap.TapRbacURL)
+		audit.outcome = auditOutcomeDenied
+		audit.err = err
 		h.log.Error(err)
 		renderJSONError(w, err, http.StatusForbidden)
 		return
@@ -151,14 +169,18 @@ func (h *handler) handleTap(w http.ResponseWriter, req *http.Request, p httprout
 	err = protohttp.HTTPRequestToProto(req, &tapReq)
 	if err != nil {
 		err = fmt.Errorf("Error decoding Tap Request proto: %s", err)
+		audit.err = err
 		h.log.Error(err)
 		protohttp.WriteErrorToHTTPResponse(w, err)
 		return
 	}
 
+	audit.setRequest(&tapReq)
+
 	url := protohttp.TapReqToURL(&tapReq)
 	if url != req.URL.Path {
 		err = fmt.Errorf("tap request body did not match APIServer URL: %+v != %+v", url, req.URL.Path)
+		audit.err = err
 		h.log.Error(err)
 		protohttp.WriteErrorToHTTPResponse(w, err)
 		return
@@ -166,6 +188,7 @@ func (h *handler) handleTap(w http.ResponseWriter, req *http.Request, p httprout
 
 	flushableWriter, err := protohttp.NewStreamingWriter(w)
 	if err != nil {
+		audit.err = err
 		h.log.Error(err)
 		protohttp.WriteErrorToHTTPResponse(w, err)
 		return
@@ -173,11 +196,14 @@ func (h *handler) handleTap(w http.ResponseWriter, req *http.Request, p httprout
 
 	serverStream := serverStream{w: flushableWriter, req: req, log: h.log}
 	err = h.grpcTapServer.TapByResource(&tapReq, &serverStream)
+	audit.events = serverStream.events
 	if err != nil {
+		audit.err = err
 		h.log.Error(err)
 		protohttp.WriteErrorToHTTPResponse(flushableWriter, err)
 		return
 	}
+	audit.outcome = auditOutcomeAllowed
 }
 
 // GET (not found)
@@ -371,9 +397,10 @@ func renderJSONError(w http.ResponseWriter, err error, status int) {
 // TODO: Share this code with streamServer and destinationServer in
 // http_server.go.
 type serverStream struct {
-	w   protohttp.FlushableResponseWriter
-	req *http.Request
-	log *logrus.Entry
+	w      protohttp.FlushableResponseWriter
+	req    *http.Request
+	log    *logrus.Entry
+	events int
 }
 
 // Satisfy the grpc.ServerStream interface
@@ -394,5 +421,6 @@ func (s *serverStream) Send(m *public.TapEvent) error {
 	}
 
 	s.w.Flush()
+	s.events++
 	return nil
 }
@@ -53,8 +53,9 @@ func TestHandleTap(t *testing.T) {
 			}
 
 			h := &handler{
-				k8sAPI: k8sAPI,
-				log:    logrus.WithField("test", t.Name()),
+				k8sAPI:  k8sAPI,
+				auditor: NewAuditor(k8sAPI, false),
+				log:     logrus.WithField("test", t.Name()),
 			}
 			recorder := httptest.NewRecorder()
 			h.handleTap(recorder, exp.req, exp.params)
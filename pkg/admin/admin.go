@@ -12,14 +12,23 @@ import (
 
 type handler struct {
 	promHandler http.Handler
+	ready       func() error
 }
 
 // StartServer starts an admin server listening on a given address.
 func StartServer(addr string) {
+	StartServerWithReadiness(addr, nil)
+}
+
+// StartServerWithReadiness starts an admin server listening on a given
+// address, whose /ready endpoint reports not-ready while ready returns an
+// error.
+func StartServerWithReadiness(addr string, ready func() error) {
 	log.Infof("starting admin server on %s", addr)
 
 	h := &handler{
 		promHandler: promhttp.Handler(),
+		ready:       ready,
 	}
 
 	log.Fatal(http.ListenAndServe(addr, h))
@@ -56,5 +65,12 @@ func (h *handler) servePing(w http.ResponseWriter) {
 }
 
 func (h *handler) serveReady(w http.ResponseWriter) {
+	if h.ready != nil {
+		if err := h.ready(); err != nil {
+			log.Debugf("not ready: %s", err)
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+	}
 	w.Write([]byte("ok\n"))
 }
@@ -32,6 +32,7 @@ type (
 		RestrictDashboardPrivileges bool              `json:"restrictDashboardPrivileges"`
 		DisableHeartBeat            bool              `json:"disableHeartBeat"`
 		HeartbeatSchedule           string            `json:"heartbeatSchedule"`
+		HealthChecker               *HealthChecker    `json:"healthChecker"`
 		InstallNamespace            bool              `json:"installNamespace"`
 		Configs                     ConfigJSONs       `json:"configs"`
 		Global                      *Global           `json:"global"`
@@ -46,6 +47,7 @@ type (
 
 		DestinationResources   *Resources `json:"destinationResources"`
 		HeartbeatResources     *Resources `json:"heartbeatResources"`
+		HealthCheckerResources *Resources `json:"healthCheckerResources"`
 		IdentityResources      *Resources `json:"identityResources"`
 		ProxyInjectorResources *Resources `json:"proxyInjectorResources"`
 		PublicAPIResources     *Resources `json:"publicAPIResources"`
@@ -54,6 +56,7 @@ type (
 		WebResources           *Resources `json:"webResources"`
 
 		DestinationProxyResources   *Resources `json:"destinationProxyResources"`
+		HealthCheckerProxyResources *Resources `json:"healthCheckerProxyResources"`
 		IdentityProxyResources      *Resources `json:"identityProxyResources"`
 		ProxyInjectorProxyResources *Resources `json:"proxyInjectorProxyResources"`
 		PublicAPIProxyResources     *Resources `json:"publicAPIProxyResources"`
@@ -113,6 +116,7 @@ type (
 		DisableTap                    bool             `json:"disableTap"`
 		EnableExternalProfiles        bool             `json:"enableExternalProfiles"`
 		DestinationGetNetworks        string           `json:"destinationGetNetworks"`
+		IdentityToken                 *IdentityToken   `json:"identityToken"`
 		Image                         *Image           `json:"image"`
 		LogLevel                      string           `json:"logLevel"`
 		LogFormat                     string           `json:"logFormat"`
@@ -129,6 +133,13 @@ type (
 		OpaquePorts                   string           `json:"opaquePorts"`
 	}
 
+	// IdentityToken contains the fields to set the projected service account
+	// token presented by the proxy to the identity controller
+	IdentityToken struct {
+		Audience          string `json:"audience"`
+		ExpirationSeconds int64  `json:"expirationSeconds"`
+	}
+
 	// ProxyInit contains the fields to set the proxy-init container
 	ProxyInit struct {
 		Capabilities         *Capabilities    `json:"capabilities"`
@@ -195,7 +206,34 @@ type (
 	// Identity contains the fields to set the identity variables in the proxy
 	// sidecar container
 	Identity struct {
-		Issuer *Issuer `json:"issuer"`
+		Issuer            *Issuer         `json:"issuer"`
+		AllowLegacyTokens bool            `json:"allowLegacyTokens"`
+		ExternalSigner    *ExternalSigner `json:"externalSigner"`
+	}
+
+	// ExternalSigner has the Helm variables of the external signer CSRs are
+	// forwarded to, instead of being signed with the issuer credentials
+	ExternalSigner struct {
+		Type        string             `json:"type"`
+		Vault       *VaultSigner       `json:"vault"`
+		CertManager *CertManagerSigner `json:"certManager"`
+	}
+
+	// VaultSigner has the Helm variables of the Vault external signer
+	VaultSigner struct {
+		Addr            string `json:"addr"`
+		SignPath        string `json:"signPath"`
+		Namespace       string `json:"namespace"`
+		TokenSecretName string `json:"tokenSecretName"`
+		CASecretName    string `json:"caSecretName"`
+	}
+
+	// CertManagerSigner has the Helm variables of the cert-manager external
+	// signer
+	CertManagerSigner struct {
+		IssuerName  string `json:"issuerName"`
+		IssuerKind  string `json:"issuerKind"`
+		IssuerGroup string `json:"issuerGroup"`
 	}
 
 	// Issuer has the Helm variables of the identity issuer
@@ -221,6 +259,14 @@ type (
 	// Tap has all the Tap's Helm variables
 	Tap struct {
 		*TLS
+		AuditEvents bool `json:"auditEvents"`
+	}
+
+	// HealthChecker has all the health checker's Helm variables
+	HealthChecker struct {
+		Enabled    bool     `json:"enabled"`
+		Interval   string   `json:"interval"`
+		Categories []string `json:"categories"`
 	}
 
 	// TLS has a pair of PEM-encoded key and certificate variables used in the
@@ -81,6 +81,10 @@ func TestNewValues(t *testing.T) {
 					CollectorSvcAddr:    "",
 					CollectorSvcAccount: "default",
 				},
+				IdentityToken: &IdentityToken{
+					Audience:          "identity.l5d.io",
+					ExpirationSeconds: 3600,
+				},
 				UID:                    2102,
 				WaitBeforeExitSeconds:  0,
 				DestinationGetNetworks: "10.0.0.0/8,172.16.0.0/12,192.168.0.0/16",
@@ -117,6 +121,14 @@ func TestNewValues(t *testing.T) {
 				TLS:                 &IssuerTLS{},
 				Scheme:              "linkerd.io/tls",
 			},
+			AllowLegacyTokens: true,
+			ExternalSigner: &ExternalSigner{
+				Vault: &VaultSigner{},
+				CertManager: &CertManagerSigner{
+					IssuerKind:  "Issuer",
+					IssuerGroup: "cert-manager.io",
+				},
+			},
 		},
 		NodeSelector: map[string]string{
 			"beta.kubernetes.io/os": "linux",
@@ -131,6 +143,11 @@ func TestNewValues(t *testing.T) {
 				Version:    testVersion,
 			},
 		},
+		HealthChecker: &HealthChecker{
+			Enabled:    false,
+			Interval:   "5m",
+			Categories: []string{},
+		},
 
 		ProxyInjector:    &ProxyInjector{TLS: &TLS{}},
 		ProfileValidator: &ProfileValidator{TLS: &TLS{}},
@@ -184,6 +201,7 @@ func TestNewValues(t *testing.T) {
 		expected.TapResources = controllerResources
 		expected.WebResources = controllerResources
 		expected.HeartbeatResources = controllerResources
+		expected.HealthCheckerResources = controllerResources
 
 		expected.Grafana = Grafana{
 			"enabled": true,
@@ -9,12 +9,15 @@ import (
 	"io"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/linkerd/linkerd2/controller/api/public"
+	idl "github.com/linkerd/linkerd2/controller/gen/apis/identity/v1alpha1"
 	healthcheckPb "github.com/linkerd/linkerd2/controller/gen/common/healthcheck"
 	configPb "github.com/linkerd/linkerd2/controller/gen/config"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	idctl "github.com/linkerd/linkerd2/controller/identity"
 	l5dcharts "github.com/linkerd/linkerd2/pkg/charts/linkerd2"
 	"github.com/linkerd/linkerd2/pkg/config"
 	"github.com/linkerd/linkerd2/pkg/identity"
@@ -35,6 +38,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	yamlDecoder "k8s.io/apimachinery/pkg/util/yaml"
 	k8sVersion "k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	apiregistrationv1client "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset/typed/apiregistration/v1"
 	"sigs.k8s.io/yaml"
@@ -158,16 +162,12 @@ const (
 	// the api service is available or not
 	linkerdTapAPIServiceName = "v1alpha1.tap.linkerd.io"
 
-	tapOldTLSSecretName           = "linkerd-tap-tls"
-	tapTLSSecretName              = "linkerd-tap-k8s-tls"
-	proxyInjectorOldTLSSecretName = "linkerd-proxy-injector-tls"
-	proxyInjectorTLSSecretName    = "linkerd-proxy-injector-k8s-tls"
-	spValidatorOldTLSSecretName   = "linkerd-sp-validator-tls"
-	spValidatorTLSSecretName      = "linkerd-sp-validator-k8s-tls"
-	certOldKeyName                = "crt.pem"
-	certKeyName                   = "tls.crt"
-	keyOldKeyName                 = "key.pem"
-	keyKeyName                    = "tls.key"
+	tlsSecretSuffix    = "-k8s-tls"
+	oldTLSSecretSuffix = "-tls"
+	certOldKeyName     = "crt.pem"
+	certKeyName        = "tls.crt"
+	keyOldKeyName      = "key.pem"
+	keyKeyName         = "tls.key"
 )
 
 // HintBaseURL is the base URL on the linkerd.io website that all check hints
@@ -175,6 +175,16 @@ const (
 // page.
 const HintBaseURL = "https://linkerd.io/checks/#"
 
+// HintURL returns the URL of the hints for a check's hintAnchor. Check
+// plugins may provide their own hints with an absolute URL instead of an
+// anchor, which is returned as is.
+func HintURL(hintAnchor string) string {
+	if strings.Contains(hintAnchor, "://") {
+		return hintAnchor
+	}
+	return HintBaseURL + hintAnchor
+}
+
 // AllowedClockSkew sets the allowed skew in clock synchronization
 // between the system running inject command and the node(s), being
 // based on assumed node's heartbeat interval (5 minutes) plus default TLS
@@ -319,6 +329,14 @@ type checker struct {
 	// check using the SelfCheck gRPC endpoint; check status is based on the value
 	// of the gRPC response
 	checkRPC func(context.Context) (*healthcheckPb.SelfCheckResponse, error)
+
+	// checkPlugin is an alternative to check that runs an external check
+	// plugin; check status is based on each of the checks the plugin reports
+	checkPlugin func(context.Context) (*pluginOutput, error)
+
+	// fix plans the remediation of a failure of check; it should only be set
+	// for changes that are safe to make to a cluster
+	fix func(context.Context) (*Fix, error)
 }
 
 // CheckResult encapsulates a check's identifying information and output
@@ -331,6 +349,17 @@ type CheckResult struct {
 	Retry       bool
 	Warning     bool
 	Err         error
+	// PlanFix is set on the failures of checks that can be fixed, and returns
+	// the fix for the failure.
+	PlanFix func(context.Context) (*Fix, error) `json:"-"`
+}
+
+// Fix is a remediation action for a failed check.
+type Fix struct {
+	// Description details the changes the fix makes.
+	Description string
+	// Apply makes the changes.
+	Apply func(context.Context) error
 }
 
 // CheckObserver receives the results of each check.
@@ -340,8 +369,32 @@ type category struct {
 	id       CategoryID
 	checkers []checker
 	enabled  bool
+
+	// populates lists the HealthChecker state set by the checkers of the
+	// category, and reads the state they rely on being set by earlier
+	// categories. RunChecks runs a category concurrently with the categories
+	// before it, unless they populate state it reads or populates, or read
+	// state it populates. A category that declares neither is run on its own.
+	populates []state
+	reads     []state
 }
 
+// state identifies HealthChecker members that are set in the process of
+// running checks.
+type state string
+
+const (
+	kubeAPIState          state = "kubeAPI"          // kubeAPI, kubeVersion
+	linkerdConfigState    state = "linkerdConfig"    // linkerdConfig, uuid
+	controlPlanePodsState state = "controlPlanePods" // controlPlanePods
+	apiClientState        state = "apiClient"        // apiClient, serverVersion
+	latestVersionsState   state = "latestVersions"   // latestVersions
+	issuerCertState       state = "issuerCert"       // issuerCert, trustAnchors
+	cniDaemonSetState     state = "cniDaemonSet"     // cniDaemonSet
+	linksState            state = "links"            // links
+	addOnsState           state = "addOns"           // addOns
+)
+
 // Options specifies configuration for a HealthChecker.
 type Options struct {
 	ControlPlaneNamespace string
@@ -410,6 +463,8 @@ func NewHealthChecker(categoryIDs []CategoryID, options *Options) *HealthChecker
 //
 // Ordering is important because checks rely on specific `HealthChecker` members
 // getting populated by earlier checks, such as kubeAPI, controlPlanePods, etc.
+// Each category declares the members it populates and reads, so that the
+// categories that don't depend on each other can be run concurrently.
 //
 // Note that all checks should include a `hintAnchor` with a corresponding section
 // in the linkerd check faq:
@@ -417,7 +472,8 @@ func NewHealthChecker(categoryIDs []CategoryID, options *Options) *HealthChecker
 func (hc *HealthChecker) allCategories() []category {
 	return []category{
 		{
-			id: KubernetesAPIChecks,
+			id:        KubernetesAPIChecks,
+			populates: []state{kubeAPIState},
 			checkers: []checker{
 				{
 					description: "can initialize the client",
@@ -440,7 +496,8 @@ func (hc *HealthChecker) allCategories() []category {
 			},
 		},
 		{
-			id: KubernetesVersionChecks,
+			id:    KubernetesVersionChecks,
+			reads: []state{kubeAPIState},
 			checkers: []checker{
 				{
 					description: "is running the minimum Kubernetes API version",
@@ -459,7 +516,8 @@ func (hc *HealthChecker) allCategories() []category {
 			},
 		},
 		{
-			id: LinkerdPreInstallChecks,
+			id:    LinkerdPreInstallChecks,
+			reads: []state{kubeAPIState},
 			checkers: []checker{
 				{
 					description: "control plane namespace does not already exist",
@@ -542,7 +600,8 @@ func (hc *HealthChecker) allCategories() []category {
 			},
 		},
 		{
-			id: LinkerdPreInstallCapabilityChecks,
+			id:    LinkerdPreInstallCapabilityChecks,
+			reads: []state{kubeAPIState},
 			checkers: []checker{
 				{
 					description: "has NET_ADMIN capability",
@@ -563,7 +622,8 @@ func (hc *HealthChecker) allCategories() []category {
 			},
 		},
 		{
-			id: LinkerdPreInstallGlobalResourcesChecks,
+			id:    LinkerdPreInstallGlobalResourcesChecks,
+			reads: []state{kubeAPIState},
 			checkers: []checker{
 				{
 					description: "no ClusterRoles exist",
@@ -610,7 +670,9 @@ func (hc *HealthChecker) allCategories() []category {
 			},
 		},
 		{
-			id: LinkerdControlPlaneExistenceChecks,
+			id:        LinkerdControlPlaneExistenceChecks,
+			populates: []state{linkerdConfigState, controlPlanePodsState, apiClientState},
+			reads:     []state{kubeAPIState},
 			checkers: []checker{
 				{
 					description: "'linkerd-config' config map exists",
@@ -709,7 +771,8 @@ func (hc *HealthChecker) allCategories() []category {
 			},
 		},
 		{
-			id: LinkerdConfigChecks,
+			id:    LinkerdConfigChecks,
+			reads: []state{kubeAPIState},
 			checkers: []checker{
 				{
 					description: "control plane Namespace exists",
@@ -778,7 +841,9 @@ func (hc *HealthChecker) allCategories() []category {
 			},
 		},
 		{
-			id: LinkerdCNIPluginChecks,
+			id:        LinkerdCNIPluginChecks,
+			populates: []state{cniDaemonSetState},
+			reads:     []state{kubeAPIState, linkerdConfigState},
 			checkers: []checker{
 				{
 					description: "cni plugin ConfigMap exists",
@@ -923,7 +988,9 @@ func (hc *HealthChecker) allCategories() []category {
 			},
 		},
 		{
-			id: LinkerdIdentity,
+			id:        LinkerdIdentity,
+			populates: []state{issuerCertState},
+			reads:     []state{kubeAPIState, linkerdConfigState},
 			checkers: []checker{
 				{
 					description: "certificate config is valid",
@@ -1026,24 +1093,29 @@ func (hc *HealthChecker) allCategories() []category {
 						return hc.issuerCert.Verify(tls.CertificatesToPool(hc.trustAnchors), hc.issuerIdentity(), time.Time{})
 					},
 				},
+				{
+					description: "identity deny-lists are valid",
+					hintAnchor:  "l5d-identity-deny-lists-valid",
+					check: func(ctx context.Context) error {
+						return CheckIdentityDenyLists(ctx, hc.kubeAPI.DynamicClient)
+					},
+				},
 			},
 		},
 		{
-			id: LinkerdWebhooksAndAPISvcTLS,
+			id:    LinkerdWebhooksAndAPISvcTLS,
+			reads: []state{kubeAPIState},
 			checkers: []checker{
 				{
 					description: "tap API server has valid cert",
 					hintAnchor:  "l5d-tap-cert-valid",
 					fatal:       true,
 					check: func(ctx context.Context) (err error) {
-						anchors, err := hc.fetchTapCaBundle(ctx)
+						anchors, err := FetchTapCaBundle(ctx, hc.kubeAPI)
 						if err != nil {
 							return err
 						}
-						cert, err := hc.fetchCredsFromSecret(ctx, tapTLSSecretName)
-						if kerrors.IsNotFound(err) {
-							cert, err = hc.fetchCredsFromOldSecret(ctx, tapOldTLSSecretName)
-						}
+						cert, err := FetchWebhookCreds(ctx, hc.kubeAPI, hc.ControlPlaneNamespace, k8s.TapServiceName)
 						if err != nil {
 							return err
 						}
@@ -1057,14 +1129,11 @@ func (hc *HealthChecker) allCategories() []category {
 					hintAnchor:  "l5d-proxy-injector-webhook-cert-valid",
 					fatal:       true,
 					check: func(ctx context.Context) (err error) {
-						anchors, err := hc.fetchProxyInjectorCaBundle(ctx)
+						anchors, err := FetchProxyInjectorCaBundle(ctx, hc.kubeAPI)
 						if err != nil {
 							return err
 						}
-						cert, err := hc.fetchCredsFromSecret(ctx, proxyInjectorTLSSecretName)
-						if kerrors.IsNotFound(err) {
-							cert, err = hc.fetchCredsFromOldSecret(ctx, proxyInjectorOldTLSSecretName)
-						}
+						cert, err := FetchWebhookCreds(ctx, hc.kubeAPI, hc.ControlPlaneNamespace, k8s.ProxyInjectorWebhookServiceName)
 						if err != nil {
 							return err
 						}
@@ -1078,14 +1147,11 @@ func (hc *HealthChecker) allCategories() []category {
 					hintAnchor:  "l5d-sp-validator-webhook-cert-valid",
 					fatal:       true,
 					check: func(ctx context.Context) (err error) {
-						anchors, err := hc.fetchSpValidatorCaBundle(ctx)
+						anchors, err := FetchSpValidatorCaBundle(ctx, hc.kubeAPI)
 						if err != nil {
 							return err
 						}
-						cert, err := hc.fetchCredsFromSecret(ctx, spValidatorTLSSecretName)
-						if kerrors.IsNotFound(err) {
-							cert, err = hc.fetchCredsFromOldSecret(ctx, spValidatorOldTLSSecretName)
-						}
+						cert, err := FetchWebhookCreds(ctx, hc.kubeAPI, hc.ControlPlaneNamespace, k8s.SPValidatorWebhookServiceName)
 						if err != nil {
 							return err
 						}
@@ -1096,7 +1162,8 @@ func (hc *HealthChecker) allCategories() []category {
 			},
 		},
 		{
-			id: LinkerdIdentityDataPlane,
+			id:    LinkerdIdentityDataPlane,
+			reads: []state{kubeAPIState},
 			checkers: []checker{
 				{
 					description: "data plane proxies certificate match CA",
@@ -1105,11 +1172,20 @@ func (hc *HealthChecker) allCategories() []category {
 					check: func(ctx context.Context) error {
 						return hc.checkDataPlaneProxiesCertificate(ctx)
 					},
+					fix: func(ctx context.Context) (*Fix, error) {
+						pods, err := hc.getPodsWithOutdatedTrustAnchors(ctx)
+						if err != nil {
+							return nil, err
+						}
+						return hc.restartWorkloadsFix(ctx, pods)
+					},
 				},
 			},
 		},
 		{
-			id: LinkerdAPIChecks,
+			id:        LinkerdAPIChecks,
+			populates: []state{controlPlanePodsState},
+			reads:     []state{kubeAPIState, apiClientState},
 			checkers: []checker{
 				{
 					description:         "control plane pods are ready",
@@ -1150,7 +1226,9 @@ func (hc *HealthChecker) allCategories() []category {
 			},
 		},
 		{
-			id: LinkerdVersionChecks,
+			id:        LinkerdVersionChecks,
+			populates: []state{latestVersionsState},
+			reads:     []state{linkerdConfigState},
 			checkers: []checker{
 				{
 					description: "can determine the latest version",
@@ -1180,7 +1258,8 @@ func (hc *HealthChecker) allCategories() []category {
 			},
 		},
 		{
-			id: LinkerdControlPlaneVersionChecks,
+			id:    LinkerdControlPlaneVersionChecks,
+			reads: []state{apiClientState, latestVersionsState},
 			checkers: []checker{
 				{
 					description: "control plane is up-to-date",
@@ -1204,7 +1283,8 @@ func (hc *HealthChecker) allCategories() []category {
 			},
 		},
 		{
-			id: LinkerdDataPlaneChecks,
+			id:    LinkerdDataPlaneChecks,
+			reads: []state{kubeAPIState, linkerdConfigState, apiClientState, latestVersionsState},
 			checkers: []checker{
 				{
 					description: "data plane namespace exists",
@@ -1256,11 +1336,8 @@ func (hc *HealthChecker) allCategories() []category {
 						}
 
 						outdatedPods := []string{}
-						for _, pod := range pods {
-							err = hc.latestVersions.Match(pod.ProxyVersion)
-							if err != nil {
-								outdatedPods = append(outdatedPods, fmt.Sprintf("\t* %s (%s)", pod.Name, pod.ProxyVersion))
-							}
+						for _, pod := range hc.outdatedDataPlanePods(pods) {
+							outdatedPods = append(outdatedPods, fmt.Sprintf("\t* %s (%s)", pod.Name, pod.ProxyVersion))
 						}
 						if len(outdatedPods) > 0 {
 							podList := strings.Join(outdatedPods, "\n")
@@ -1268,6 +1345,40 @@ func (hc *HealthChecker) allCategories() []category {
 						}
 						return nil
 					},
+					fix: func(ctx context.Context) (*Fix, error) {
+						pods, err := hc.getDataPlanePods(ctx)
+						if err != nil {
+							return nil, err
+						}
+						// restarted pods are injected with the proxy version of
+						// the installed control plane, so restarting the pods
+						// already running it wouldn't update them
+						installed := hc.installedProxyVersion()
+						outdatedPods := podsNotRunningVersion(hc.outdatedDataPlanePods(pods), installed)
+						if len(outdatedPods) == 0 {
+							return nil, fmt.Errorf("the outdated pods already run the proxy version of the control plane (%s); the control plane must be upgraded first", installed)
+						}
+						return hc.restartWorkloadsFix(ctx, outdatedPods)
+					},
+				},
+				{
+					description: "data plane proxies configuration is up-to-date",
+					hintAnchor:  "l5d-data-plane-config-drift",
+					warning:     true,
+					check: func(ctx context.Context) error {
+						_, err := hc.checkProxyDrift(ctx)
+						return err
+					},
+					fix: func(ctx context.Context) (*Fix, error) {
+						pods, err := hc.checkProxyDrift(ctx)
+						if len(pods) == 0 {
+							if err == nil {
+								err = errors.New("no pod to restart")
+							}
+							return nil, err
+						}
+						return hc.restartWorkloadsFix(ctx, pods)
+					},
 				},
 				{
 					description: "data plane and cli versions match",
@@ -1290,7 +1401,8 @@ func (hc *HealthChecker) allCategories() []category {
 			},
 		},
 		{
-			id: LinkerdHAChecks,
+			id:    LinkerdHAChecks,
+			reads: []state{kubeAPIState, linkerdConfigState},
 			checkers: []checker{
 				{
 					description: "pod injection disabled on kube-system",
@@ -1306,6 +1418,7 @@ func (hc *HealthChecker) allCategories() []category {
 						}
 						return &SkipError{Reason: "not run for non HA installs"}
 					},
+					fix: hc.fixKubeSystemNamespaceLabel,
 				},
 				{
 					description:   "multiple replicas of control plane pods",
@@ -1419,45 +1532,210 @@ func (hc *HealthChecker) addCategory(c category) {
 // remaining checks are skipped. If at least one check fails, RunChecks returns
 // false; if all checks passed, RunChecks returns true.  Checks which are
 // designated as warnings will not cause RunCheck to return false, however.
+//
+// Categories that don't depend on each other are run concurrently, but the
+// observer is always called from the calling goroutine, with the results in
+// the order of the categories, as if they had been run one after the other.
 func (hc *HealthChecker) RunChecks(observer CheckObserver) bool {
-	success := true
+	ctx, cancel := context.WithCancel(context.Background())
+	// Stops the categories still running after a fatal failure.
+	defer cancel()
+
+	categories := []category{}
 	for _, c := range hc.categories {
 		if c.enabled {
-			for _, checker := range c.checkers {
-				checker := checker // pin
-				if checker.check != nil {
-					if !hc.runCheck(c.id, &checker, observer) {
-						if !checker.warning {
-							success = false
-						}
-						if checker.fatal {
-							return success
-						}
-					}
-				}
+			categories = append(categories, c)
+		}
+	}
 
-				if checker.checkRPC != nil {
-					if !hc.runCheckRPC(c.id, &checker, observer) {
-						if !checker.warning {
-							success = false
-						}
-						if checker.fatal {
-							return success
-						}
-					}
-				}
+	runs := make([]*categoryRun, len(categories))
+	for i := range categories {
+		runs[i] = newCategoryRun()
+	}
+	for i := range categories {
+		dependencies := []*categoryRun{}
+		for j := 0; j < i; j++ {
+			if dependsOn(categories[i], categories[j]) {
+				dependencies = append(dependencies, runs[j])
 			}
 		}
+		go hc.runCategory(ctx, categories[i], runs[i], dependencies)
+	}
+
+	success := true
+	for _, run := range runs {
+		failed, fatal := run.replay(observer)
+		if failed {
+			success = false
+		}
+		if fatal {
+			return success
+		}
 	}
 
 	return success
 }
 
-func (hc *HealthChecker) runCheck(categoryID CategoryID, c *checker, observer CheckObserver) bool {
+// dependsOn returns true if category c has to wait for the category earlier,
+// which precedes it, to be done before running.
+func dependsOn(c, earlier category) bool {
+	if (len(c.populates) == 0 && len(c.reads) == 0) ||
+		(len(earlier.populates) == 0 && len(earlier.reads) == 0) {
+		return true
+	}
+	return sharesState(earlier.populates, c.reads) ||
+		sharesState(earlier.populates, c.populates) ||
+		sharesState(earlier.reads, c.populates)
+}
+
+func sharesState(a, b []state) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// runCategory runs the checkers of a category once the categories it depends
+// on are done, recording their results into run. If a fatal check fails, the
+// remaining checkers of the category are skipped, as are the categories that
+// depend on it.
+func (hc *HealthChecker) runCategory(ctx context.Context, c category, run *categoryRun, dependencies []*categoryRun) {
+	defer run.finish()
+
+	for _, dependency := range dependencies {
+		select {
+		case <-dependency.done:
+			if _, fatal := dependency.status(); fatal {
+				run.fail(false, true)
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	for _, checker := range c.checkers {
+		checker := checker // pin
+		if ctx.Err() != nil {
+			return
+		}
+
+		var ok bool
+		switch {
+		case checker.check != nil:
+			ok = hc.runCheck(ctx, c.id, &checker, run.record)
+		case checker.checkRPC != nil:
+			ok = hc.runCheckRPC(ctx, c.id, &checker, run.record)
+		case checker.checkPlugin != nil:
+			ok = hc.runCheckPlugin(ctx, c.id, &checker, run.record)
+		default:
+			continue
+		}
+		if !ok {
+			run.fail(!checker.warning, checker.fatal)
+			if checker.fatal {
+				return
+			}
+		}
+	}
+}
+
+// categoryRun buffers the results of a category, recorded by runCategory,
+// until RunChecks replays them to its observer.
+type categoryRun struct {
+	results  []*CheckResult
+	finished bool
+	// failed is set when a check that isn't a warning fails
+	failed bool
+	// fatal is set when a fatal check fails, or when the category is skipped
+	// because of a fatal failure in a category it depends on
+	fatal bool
+
+	// done is closed when the category is finished
+	done chan struct{}
+	// updated is signalled whenever a result is recorded, and on finishing
+	updated chan struct{}
+
+	// All access to the fields above but the channels is synchronized by this
+	// mutex.
+	sync.Mutex
+}
+
+func newCategoryRun() *categoryRun {
+	return &categoryRun{
+		done:    make(chan struct{}),
+		updated: make(chan struct{}, 1),
+	}
+}
+
+func (r *categoryRun) record(result *CheckResult) {
+	// Checkers may reuse the result they pass to the observer.
+	recorded := *result
+	r.Lock()
+	r.results = append(r.results, &recorded)
+	r.Unlock()
+	r.signal()
+}
+
+func (r *categoryRun) fail(failed, fatal bool) {
+	r.Lock()
+	defer r.Unlock()
+	r.failed = r.failed || failed
+	r.fatal = r.fatal || fatal
+}
+
+func (r *categoryRun) status() (bool, bool) {
+	r.Lock()
+	defer r.Unlock()
+	return r.failed, r.fatal
+}
+
+func (r *categoryRun) finish() {
+	r.Lock()
+	r.finished = true
+	r.Unlock()
+	close(r.done)
+	r.signal()
+}
+
+func (r *categoryRun) signal() {
+	select {
+	case r.updated <- struct{}{}:
+	default:
+	}
+}
+
+// replay passes the results of the category to the observer as they are
+// recorded, until the category is finished. It returns whether a check failed,
+// and whether the failure was fatal.
+func (r *categoryRun) replay(observer CheckObserver) (bool, bool) {
+	next := 0
 	for {
-		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		r.Lock()
+		results := r.results[next:]
+		next = len(r.results)
+		finished, failed, fatal := r.finished, r.failed, r.fatal
+		r.Unlock()
+
+		for _, result := range results {
+			observer(result)
+		}
+		if finished {
+			return failed, fatal
+		}
+		<-r.updated
+	}
+}
+
+func (hc *HealthChecker) runCheck(ctx context.Context, categoryID CategoryID, c *checker, observer CheckObserver) bool {
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, requestTimeout)
 		defer cancel()
-		err := c.check(ctx)
+		err := c.check(checkCtx)
 		if se, ok := err.(*SkipError); ok {
 			log.Debugf("Skipping check: %s. Reason: %s", c.description, se.Reason)
 			return true
@@ -1483,10 +1761,15 @@ func (hc *HealthChecker) runCheck(categoryID CategoryID, c *checker, observer Ch
 			log.Debugf("Retrying on error: %s", err)
 
 			observer(checkResult)
-			time.Sleep(retryWindow)
+			if !waitForRetry(ctx) {
+				return false
+			}
 			continue
 		}
 
+		if checkResult.Err != nil {
+			checkResult.PlanFix = c.fix
+		}
 		observer(checkResult)
 		return checkResult.Err == nil
 	}
@@ -1500,12 +1783,12 @@ func (hc *HealthChecker) runCheck(categoryID CategoryID, c *checker, observer Ch
 // We keep on retrying the same call until all the responses have an OK status
 // (or until timeout/deadline is reached), sending a message to `observer` for each response,
 // while making sure no duplicate messages are sent.
-func (hc *HealthChecker) runCheckRPC(categoryID CategoryID, c *checker, observer CheckObserver) bool {
+func (hc *HealthChecker) runCheckRPC(ctx context.Context, categoryID CategoryID, c *checker, observer CheckObserver) bool {
 	observedResults := []CheckResult{}
 	for {
-		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		checkCtx, cancel := context.WithTimeout(ctx, requestTimeout)
 		defer cancel()
-		checkRsp, err := c.checkRPC(ctx)
+		checkRsp, err := c.checkRPC(checkCtx)
 		if se, ok := err.(*SkipError); ok {
 			log.Debugf("Skipping check: %s. Reason: %s", c.description, se.Reason)
 			return true
@@ -1555,7 +1838,9 @@ func (hc *HealthChecker) runCheckRPC(categoryID CategoryID, c *checker, observer
 
 		if checkResult.Retry {
 			log.Debug("Retrying on error")
-			time.Sleep(retryWindow)
+			if !waitForRetry(ctx) {
+				return false
+			}
 			continue
 		}
 
@@ -1563,6 +1848,17 @@ func (hc *HealthChecker) runCheckRPC(categoryID CategoryID, c *checker, observer
 	}
 }
 
+// waitForRetry waits for retryWindow before a check is retried. It returns
+// false if ctx is done first, in which case the check shouldn't be retried.
+func waitForRetry(ctx context.Context) bool {
+	select {
+	case <-time.After(retryWindow):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 func (hc *HealthChecker) controlPlaneComponentsSelector() string {
 	return fmt.Sprintf("%s,!%s", k8s.ControllerNSLabel, LinkerdCNIResourceLabel)
 }
@@ -1647,8 +1943,10 @@ func FetchCurrentConfiguration(ctx context.Context, k kubernetes.Interface, cont
 	return configMap, config.ToValues(configPb), nil
 }
 
-func (hc *HealthChecker) fetchProxyInjectorCaBundle(ctx context.Context) ([]*x509.Certificate, error) {
-	mwh, err := hc.getProxyInjectorMutatingWebhook(ctx)
+// FetchProxyInjectorCaBundle retrieves the CA bundle the proxy-injector
+// webhook is registered with
+func FetchProxyInjectorCaBundle(ctx context.Context, k kubernetes.Interface) ([]*x509.Certificate, error) {
+	mwh, err := getProxyInjectorMutatingWebhook(ctx, k)
 	if err != nil {
 		return nil, err
 	}
@@ -1660,9 +1958,10 @@ func (hc *HealthChecker) fetchProxyInjectorCaBundle(ctx context.Context) ([]*x50
 	return caBundle, nil
 }
 
-func (hc *HealthChecker) fetchSpValidatorCaBundle(ctx context.Context) ([]*x509.Certificate, error) {
-
-	vwc, err := hc.kubeAPI.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get(ctx, k8s.SPValidatorWebhookConfigName, metav1.GetOptions{})
+// FetchSpValidatorCaBundle retrieves the CA bundle the sp-validator webhook
+// is registered with
+func FetchSpValidatorCaBundle(ctx context.Context, k kubernetes.Interface) ([]*x509.Certificate, error) {
+	vwc, err := k.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get(ctx, k8s.SPValidatorWebhookConfigName, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -1678,13 +1977,10 @@ func (hc *HealthChecker) fetchSpValidatorCaBundle(ctx context.Context) ([]*x509.
 	return caBundle, nil
 }
 
-func (hc *HealthChecker) fetchTapCaBundle(ctx context.Context) ([]*x509.Certificate, error) {
-	apiServiceClient, err := apiregistrationv1client.NewForConfig(hc.kubeAPI.Config)
-	if err != nil {
-		return nil, err
-	}
-
-	apiService, err := apiServiceClient.APIServices().Get(ctx, linkerdTapAPIServiceName, metav1.GetOptions{})
+// FetchTapCaBundle retrieves the CA bundle the tap APIService is registered
+// with
+func FetchTapCaBundle(ctx context.Context, k *k8s.KubernetesAPI) ([]*x509.Certificate, error) {
+	apiService, err := k.Apiregistration.ApiregistrationV1().APIServices().Get(ctx, linkerdTapAPIServiceName, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -1696,8 +1992,19 @@ func (hc *HealthChecker) fetchTapCaBundle(ctx context.Context) ([]*x509.Certific
 	return caBundle, nil
 }
 
-func (hc *HealthChecker) fetchCredsFromSecret(ctx context.Context, secretName string) (*tls.Cred, error) {
-	secret, err := hc.kubeAPI.CoreV1().Secrets(hc.ControlPlaneNamespace).Get(ctx, secretName, metav1.GetOptions{})
+// FetchWebhookCreds retrieves the serving credentials of the given control
+// plane webhook or APIService service, falling back to the secret name used
+// by older releases
+func FetchWebhookCreds(ctx context.Context, k kubernetes.Interface, controlPlaneNamespace, serviceName string) (*tls.Cred, error) {
+	cred, err := fetchCredsFromSecret(ctx, k, controlPlaneNamespace, serviceName+tlsSecretSuffix)
+	if kerrors.IsNotFound(err) {
+		return fetchCredsFromOldSecret(ctx, k, controlPlaneNamespace, serviceName+oldTLSSecretSuffix)
+	}
+	return cred, err
+}
+
+func fetchCredsFromSecret(ctx context.Context, k kubernetes.Interface, controlPlaneNamespace, secretName string) (*tls.Cred, error) {
+	secret, err := k.CoreV1().Secrets(controlPlaneNamespace).Get(ctx, secretName, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -1723,8 +2030,8 @@ func (hc *HealthChecker) fetchCredsFromSecret(ctx context.Context, secretName st
 // this function can be removed in later versions, once eihter all webhook secrets are recreated for each update
 // (see https://github.com/linkerd/linkerd2/issues/4813)
 // or later releases are only expected to update from the new names.
-func (hc *HealthChecker) fetchCredsFromOldSecret(ctx context.Context, secretName string) (*tls.Cred, error) {
-	secret, err := hc.kubeAPI.CoreV1().Secrets(hc.ControlPlaneNamespace).Get(ctx, secretName, metav1.GetOptions{})
+func fetchCredsFromOldSecret(ctx context.Context, k kubernetes.Interface, controlPlaneNamespace, secretName string) (*tls.Cred, error) {
+	secret, err := k.CoreV1().Secrets(controlPlaneNamespace).Get(ctx, secretName, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -1911,11 +2218,47 @@ func (hc *HealthChecker) checkCustomResourceDefinitions(ctx context.Context, sho
 		objects = append(objects, &item)
 	}
 
-	return checkResources("CustomResourceDefinitions", objects, []string{"serviceprofiles.linkerd.io"}, shouldExist)
+	return checkResources("CustomResourceDefinitions", objects, []string{"serviceprofiles.linkerd.io", "identitydenylists.identity.linkerd.io", "externalworkloads.workload.linkerd.io", "serverauthorizations.policy.linkerd.io"}, shouldExist)
+}
+
+var identityDenyListGVR = schema.GroupVersionResource{
+	Group:    k8s.IdentityDenyListAPIGroup,
+	Version:  "v1alpha1",
+	Resource: "identitydenylists",
 }
 
-func (hc *HealthChecker) getProxyInjectorMutatingWebhook(ctx context.Context) (*admissionRegistration.MutatingWebhook, error) {
-	mwc, err := hc.kubeAPI.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Get(ctx, k8s.ProxyInjectorWebhookConfigName, metav1.GetOptions{})
+// CheckIdentityDenyLists returns an error describing the invalid entries of the
+// IdentityDenyLists in the cluster, if any. Entries that can't be parsed would
+// otherwise be silently ignored by the identity and destination services.
+func CheckIdentityDenyLists(ctx context.Context, client dynamic.Interface) error {
+	list, err := client.Resource(identityDenyListGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			// the IdentityDenyList CRD hasn't been installed yet
+			return nil
+		}
+		return err
+	}
+
+	errs := []string{}
+	for _, u := range list.Items {
+		var denyList idl.IdentityDenyList
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &denyList); err != nil {
+			errs = append(errs, fmt.Sprintf("failed to parse IdentityDenyList %s: %s", u.GetName(), err))
+			continue
+		}
+		if err := idctl.ValidateDenyList(&denyList); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid identity deny-lists:\n\t%s", strings.Join(errs, "\n\t"))
+	}
+	return nil
+}
+
+func getProxyInjectorMutatingWebhook(ctx context.Context, k kubernetes.Interface) (*admissionRegistration.MutatingWebhook, error) {
+	mwc, err := k.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Get(ctx, k8s.ProxyInjectorWebhookConfigName, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -1926,7 +2269,7 @@ func (hc *HealthChecker) getProxyInjectorMutatingWebhook(ctx context.Context) (*
 }
 
 func (hc *HealthChecker) getMutatingWebhookFailurePolicy(ctx context.Context) (*admissionRegistration.FailurePolicyType, error) {
-	mwh, err := hc.getProxyInjectorMutatingWebhook(ctx)
+	mwh, err := getProxyInjectorMutatingWebhook(ctx, hc.kubeAPI)
 	if err != nil {
 		return nil, err
 	}
@@ -2022,31 +2365,89 @@ func GetMeshedPodsIdentityData(ctx context.Context, api kubernetes.Interface, da
 }
 
 func (hc *HealthChecker) checkDataPlaneProxiesCertificate(ctx context.Context) error {
-	meshedPods, err := GetMeshedPodsIdentityData(ctx, hc.kubeAPI.Interface, hc.DataPlaneNamespace)
+	pods, err := hc.getPodsWithOutdatedTrustAnchors(ctx)
 	if err != nil {
 		return err
 	}
 
+	offendingPods := []string{}
+	for _, pod := range pods {
+		if hc.DataPlaneNamespace == "" {
+			offendingPods = append(offendingPods, fmt.Sprintf("* %s/%s", pod.namespace, pod.name))
+		} else {
+			offendingPods = append(offendingPods, fmt.Sprintf("* %s", pod.name))
+		}
+	}
+	if len(offendingPods) == 0 {
+		return nil
+	}
+	return fmt.Errorf("Some pods do not have the current trust bundle and must be restarted:\n\t%s", strings.Join(offendingPods, "\n\t"))
+}
+
+// getPodsWithOutdatedTrustAnchors returns the meshed pods whose proxies don't
+// have the current trust anchors.
+func (hc *HealthChecker) getPodsWithOutdatedTrustAnchors(ctx context.Context) ([]podRef, error) {
+	meshedPods, err := GetMeshedPodsIdentityData(ctx, hc.kubeAPI.Interface, hc.DataPlaneNamespace)
+	if err != nil {
+		return nil, err
+	}
+
 	_, values, err := FetchCurrentConfiguration(ctx, hc.kubeAPI, hc.ControlPlaneNamespace)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	trustAnchorsPem := values.Global.IdentityTrustAnchorsPEM
-	offendingPods := []string{}
+	pods := []podRef{}
 	for _, pod := range meshedPods {
 		if strings.TrimSpace(pod.Anchors) != strings.TrimSpace(trustAnchorsPem) {
-			if hc.DataPlaneNamespace == "" {
-				offendingPods = append(offendingPods, fmt.Sprintf("* %s/%s", pod.Namespace, pod.Name))
-			} else {
-				offendingPods = append(offendingPods, fmt.Sprintf("* %s", pod.Name))
-			}
+			pods = append(pods, podRef{namespace: pod.Namespace, name: pod.Name})
 		}
 	}
-	if len(offendingPods) == 0 {
-		return nil
+	return pods, nil
+}
+
+// outdatedDataPlanePods returns the data plane pods whose proxies aren't
+// running the latest version.
+func (hc *HealthChecker) outdatedDataPlanePods(pods []*pb.Pod) []*pb.Pod {
+	outdated := []*pb.Pod{}
+	for _, pod := range pods {
+		if err := hc.latestVersions.Match(pod.ProxyVersion); err != nil {
+			outdated = append(outdated, pod)
+		}
 	}
-	return fmt.Errorf("Some pods do not have the current trust bundle and must be restarted:\n\t%s", strings.Join(offendingPods, "\n\t"))
+	return outdated
+}
+
+// installedProxyVersion returns the proxy version pods are injected with by
+// the installed control plane.
+func (hc *HealthChecker) installedProxyVersion() string {
+	if hc.linkerdConfig != nil && hc.linkerdConfig.Global != nil {
+		global := hc.linkerdConfig.Global
+		if global.Proxy != nil && global.Proxy.Image != nil && global.Proxy.Image.Version != "" {
+			return global.Proxy.Image.Version
+		}
+		if global.LinkerdVersion != "" {
+			return global.LinkerdVersion
+		}
+	}
+	return hc.serverVersion
+}
+
+// podsNotRunningVersion returns the pods whose proxy doesn't run the given
+// version.
+func podsNotRunningVersion(pods []*pb.Pod, proxyVersion string) []podRef {
+	refs := []podRef{}
+	for _, pod := range pods {
+		if pod.ProxyVersion == proxyVersion {
+			continue
+		}
+		parts := strings.SplitN(pod.Name, "/", 2)
+		if len(parts) == 2 {
+			refs = append(refs, podRef{namespace: parts[0], name: parts[1]})
+		}
+	}
+	return refs
 }
 
 func checkResources(resourceName string, objects []runtime.Object, expectedNames []string, shouldExist bool) error {
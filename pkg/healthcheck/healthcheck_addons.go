@@ -39,7 +39,9 @@ var (
 func (hc *HealthChecker) addOnCategories() []category {
 	return []category{
 		{
-			id: LinkerdAddOnChecks,
+			id:        LinkerdAddOnChecks,
+			populates: []state{addOnsState},
+			reads:     []state{kubeAPIState},
 			checkers: []checker{
 				{
 					description: fmt.Sprintf("'%s' config map exists", k8s.AddOnsConfigMapName),
@@ -51,7 +53,9 @@ func (hc *HealthChecker) addOnCategories() []category {
 			},
 		},
 		{
-			id: LinkerdPrometheusAddOnChecks,
+			id:        LinkerdPrometheusAddOnChecks,
+			populates: []state{controlPlanePodsState},
+			reads:     []state{kubeAPIState, addOnsState},
 			checkers: []checker{
 				{
 					description: "prometheus add-on service account exists",
@@ -96,7 +100,9 @@ func (hc *HealthChecker) addOnCategories() []category {
 			},
 		},
 		{
-			id: LinkerdGrafanaAddOnChecks,
+			id:        LinkerdGrafanaAddOnChecks,
+			populates: []state{controlPlanePodsState},
+			reads:     []state{kubeAPIState, addOnsState},
 			checkers: []checker{
 				{
 					description: "grafana add-on service account exists",
@@ -164,7 +170,9 @@ func (hc *HealthChecker) addOnCategories() []category {
 			},
 		},
 		{
-			id: LinkerdTracingAddOnChecks,
+			id:        LinkerdTracingAddOnChecks,
+			populates: []state{controlPlanePodsState},
+			reads:     []state{kubeAPIState, addOnsState},
 			checkers: []checker{
 				{
 					description: "collector service account exists",
@@ -0,0 +1,192 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	configPb "github.com/linkerd/linkerd2/controller/gen/config"
+	"github.com/linkerd/linkerd2/pkg/inject"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// maxDriftWorkers bounds the number of pods whose owner is fetched and
+// injected again at once.
+const maxDriftWorkers = 10
+
+// PodDrift lists the fields of the proxy configuration of a meshed pod that
+// differ from what the proxy injector would produce with the current
+// linkerd-config.
+type PodDrift struct {
+	Namespace string
+	Name      string
+	Drift     []inject.Drift
+}
+
+// GetProxyDrift returns the meshed pods of namespace, or of all namespaces if
+// it is empty, whose proxy configuration drifted. The pod template of each
+// pod's owner is injected again and compared to the running pod. Pods without
+// an owner, and pods whose owner template was injected with
+// `linkerd inject --manual`, are left out.
+func GetProxyDrift(ctx context.Context, k kubernetes.Interface, controlPlaneNamespace, namespace string) ([]PodDrift, error) {
+	_, configs, err := FetchLinkerdConfigMap(ctx, k, controlPlaneNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := fmt.Sprintf("%s=%s", k8s.ControllerNSLabel, controlPlaneNamespace)
+	pods, err := k.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	running := []*corev1.Pod{}
+	nsAnnotations := map[string]map[string]string{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		running = append(running, pod)
+		if _, ok := nsAnnotations[pod.Namespace]; !ok {
+			ns, err := k.CoreV1().Namespaces().Get(ctx, pod.Namespace, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			nsAnnotations[pod.Namespace] = ns.GetAnnotations()
+		}
+	}
+
+	// The owner of each pod is fetched and injected again concurrently, with
+	// at most maxDriftWorkers pods in flight.
+	drifts := make([][]inject.Drift, len(running))
+	errs := make([]error, len(running))
+	sem := make(chan struct{}, maxDriftWorkers)
+	var wg sync.WaitGroup
+	for i, pod := range running {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, pod *corev1.Pod) {
+			defer func() { <-sem; wg.Done() }()
+			drifts[i], errs[i] = getPodDrift(ctx, k, configs, nsAnnotations[pod.Namespace], pod)
+		}(i, pod)
+	}
+	wg.Wait()
+
+	drifted := []PodDrift{}
+	for i, pod := range running {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if len(drifts[i]) > 0 {
+			drifted = append(drifted, PodDrift{pod.Namespace, pod.Name, drifts[i]})
+		}
+	}
+
+	sort.Slice(drifted, func(i, j int) bool {
+		if drifted[i].Namespace != drifted[j].Namespace {
+			return drifted[i].Namespace < drifted[j].Namespace
+		}
+		return drifted[i].Name < drifted[j].Name
+	})
+	return drifted, nil
+}
+
+// getPodDrift returns the fields of the proxy configuration of the pod that
+// differ from the injection of its owner's pod template, or none if the pod
+// has no supported owner or its owner was injected manually.
+func getPodDrift(ctx context.Context, k kubernetes.Interface, configs *configPb.All, nsAnnotations map[string]string, pod *corev1.Pod) ([]inject.Drift, error) {
+	owner, err := getPodOwner(ctx, k, pod)
+	if err != nil {
+		return nil, err
+	}
+	if owner == nil {
+		return nil, nil
+	}
+	ownerYAML, err := yaml.Marshal(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := inject.NewResourceConfig(configs, inject.OriginWebhook).
+		WithNsAnnotations(nsAnnotations).
+		WithKind(owner.GetObjectKind().GroupVersionKind().Kind)
+	if _, err := conf.ParseMetaAndYAML(ownerYAML); err != nil {
+		return nil, err
+	}
+	drift, err := conf.ProxyDrift(pod)
+	if errors.Is(err, inject.ErrAlreadyInjected) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute the proxy configuration of %s/%s: %s", pod.Namespace, pod.Name, err)
+	}
+	return drift, nil
+}
+
+// getPodOwner returns the resource controlling the pod, whose pod template
+// the pod was created from, or nil if the pod has no supported owner.
+func getPodOwner(ctx context.Context, k kubernetes.Interface, pod *corev1.Pod) (runtime.Object, error) {
+	ref := metav1.GetControllerOf(pod)
+	if ref == nil {
+		return nil, nil
+	}
+
+	var obj runtime.Object
+	var err error
+	switch strings.ToLower(ref.Kind) {
+	case k8s.ReplicaSet:
+		obj, err = k.AppsV1().ReplicaSets(pod.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	case k8s.StatefulSet:
+		obj, err = k.AppsV1().StatefulSets(pod.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	case k8s.DaemonSet:
+		obj, err = k.AppsV1().DaemonSets(pod.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	case k8s.Job:
+		obj, err = k.BatchV1().Jobs(pod.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	case k8s.ReplicationController:
+		obj, err = k.CoreV1().ReplicationControllers(pod.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Objects returned by typed clients have no type metadata, which the
+	// injection needs to parse them.
+	obj.GetObjectKind().SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+	return obj, nil
+}
+
+// checkProxyDrift returns an error listing the pods whose proxy configuration
+// drifted, and the pods themselves.
+func (hc *HealthChecker) checkProxyDrift(ctx context.Context) ([]podRef, error) {
+	drifted, err := GetProxyDrift(ctx, hc.kubeAPI, hc.ControlPlaneNamespace, hc.DataPlaneNamespace)
+	if err != nil {
+		return nil, err
+	}
+	if len(drifted) == 0 {
+		return nil, nil
+	}
+
+	pods := []podRef{}
+	lines := []string{}
+	for _, pod := range drifted {
+		pods = append(pods, podRef{pod.Namespace, pod.Name})
+		fields := []string{}
+		for _, d := range pod.Drift {
+			fields = append(fields, fmt.Sprintf("%s (%s, expected %s)", d.Field, d.Current, d.Expected))
+		}
+		lines = append(lines, fmt.Sprintf("\t* %s/%s: %s", pod.Namespace, pod.Name, strings.Join(fields, ", ")))
+	}
+	return pods, fmt.Errorf("Some data plane pods are not running the current proxy configuration:\n%s", strings.Join(lines, "\n"))
+}
@@ -0,0 +1,88 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+)
+
+const driftConfigMap = `
+kind: ConfigMap
+apiVersion: v1
+metadata:
+  name: linkerd-config
+  namespace: linkerd
+data:
+  global: |
+    {"linkerdNamespace":"linkerd","cniEnabled":false,"version":"install-control-plane-version","identityContext":{"trustDomain":"cluster.local","trustAnchorsPem":"fake-trust-anchors-pem","issuanceLifetime":"86400s","clockSkewAllowance":"20s"}}
+  proxy: |
+    {"proxyImage":{"imageName":"ghcr.io/linkerd/proxy","pullPolicy":"IfNotPresent"},"proxyInitImage":{"imageName":"ghcr.io/linkerd/proxy-init","pullPolicy":"IfNotPresent"},"controlPort":{"port":4190},"inboundPort":{"port":4143},"adminPort":{"port":4191},"outboundPort":{"port":4140},"proxyUid":"2102","logLevel":{"level":"warn,linkerd=info"},"proxyVersion":"install-proxy-version"}
+  install: |
+    {"cliVersion":"dev-undefined","flags":[]}
+`
+
+const driftNamespace = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: emojivoto
+`
+
+// driftPod returns a running meshed pod, owned by the replica set if any.
+func driftPod(name, owner string) string {
+	ownerRefs := ""
+	if owner != "" {
+		ownerRefs = fmt.Sprintf(`
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: ReplicaSet
+    name: %s
+    controller: true`, owner)
+	}
+	return fmt.Sprintf(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: %s
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd%s
+status:
+  phase: Running
+`, name, ownerRefs)
+}
+
+func TestGetProxyDrift(t *testing.T) {
+	// more pods than maxDriftWorkers, so that some wait for a worker
+	pods := []string{driftConfigMap, driftNamespace}
+	for i := 0; i < 3*maxDriftWorkers; i++ {
+		pods = append(pods, driftPod(fmt.Sprintf("debug-%d", i), ""))
+	}
+
+	t.Run("pods without owner", func(t *testing.T) {
+		k, err := k8s.NewFakeAPI(pods...)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		drifted, err := GetProxyDrift(context.Background(), k, "linkerd", "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(drifted) != 0 {
+			t.Fatalf("Expected no drift, got %v", drifted)
+		}
+	})
+
+	t.Run("missing owner", func(t *testing.T) {
+		k, err := k8s.NewFakeAPI(append(pods, driftPod("web-5d8f9-x2b7q", "web-5d8f9"))...)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		expected := `replicasets.apps "web-5d8f9" not found`
+		if _, err := GetProxyDrift(context.Background(), k, "linkerd", ""); err == nil || err.Error() != expected {
+			t.Fatalf("Expected error %q, got %v", expected, err)
+		}
+	})
+}
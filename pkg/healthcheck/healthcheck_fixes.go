@@ -0,0 +1,140 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// restartedAtAnnotation is the pod template annotation `kubectl rollout
+// restart` sets to restart the pods of a workload.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+type podRef struct {
+	namespace string
+	name      string
+}
+
+// workload is a resource whose pods can be restarted by changing its pod
+// template.
+type workload struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+func (w workload) String() string {
+	return fmt.Sprintf("%s %s/%s", w.kind, w.namespace, w.name)
+}
+
+// fixKubeSystemNamespaceLabel labels the kube-system namespace so that the
+// proxy injector doesn't process its pods.
+func (hc *HealthChecker) fixKubeSystemNamespaceLabel(context.Context) (*Fix, error) {
+	return &Fix{
+		Description: fmt.Sprintf("label the kube-system namespace with %s=disabled", k8s.AdmissionWebhookLabel),
+		Apply: func(ctx context.Context) error {
+			patch := fmt.Sprintf(`{"metadata":{"labels":{%q:"disabled"}}}`, k8s.AdmissionWebhookLabel)
+			_, err := hc.kubeAPI.CoreV1().Namespaces().Patch(ctx, "kube-system", types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+			return err
+		},
+	}, nil
+}
+
+// restartWorkloadsFix restarts the workloads of the pods, as `kubectl rollout
+// restart` does, so that they are recreated with up-to-date proxies. Pods that
+// aren't managed by a deployment, statefulset or daemonset are left alone.
+func (hc *HealthChecker) restartWorkloadsFix(ctx context.Context, pods []podRef) (*Fix, error) {
+	workloads := []workload{}
+	seen := map[workload]bool{}
+	unmanaged := []string{}
+	for _, pod := range pods {
+		w, ok, err := hc.getPodWorkload(ctx, pod)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			unmanaged = append(unmanaged, fmt.Sprintf("%s/%s", pod.namespace, pod.name))
+			continue
+		}
+		if !seen[w] {
+			seen[w] = true
+			workloads = append(workloads, w)
+		}
+	}
+	if len(workloads) == 0 {
+		return nil, fmt.Errorf("no workload to restart; pods must be restarted manually: %s", strings.Join(unmanaged, ", "))
+	}
+	sort.Slice(workloads, func(i, j int) bool { return workloads[i].String() < workloads[j].String() })
+
+	names := []string{}
+	for _, w := range workloads {
+		names = append(names, w.String())
+	}
+	description := fmt.Sprintf("restart %s", strings.Join(names, ", "))
+	if len(unmanaged) > 0 {
+		description = fmt.Sprintf("%s; pods must be restarted manually: %s", description, strings.Join(unmanaged, ", "))
+	}
+
+	return &Fix{
+		Description: description,
+		Apply: func(ctx context.Context) error {
+			for _, w := range workloads {
+				if err := hc.restartWorkload(ctx, w); err != nil {
+					return fmt.Errorf("failed to restart %s: %s", w, err)
+				}
+			}
+			return nil
+		},
+	}, nil
+}
+
+// getPodWorkload returns the workload managing the pod, or false if it isn't
+// managed by a deployment, statefulset or daemonset.
+func (hc *HealthChecker) getPodWorkload(ctx context.Context, pod podRef) (workload, bool, error) {
+	p, err := hc.kubeAPI.CoreV1().Pods(pod.namespace).Get(ctx, pod.name, metav1.GetOptions{})
+	if err != nil {
+		return workload{}, false, err
+	}
+	owner := metav1.GetControllerOf(p)
+	if owner == nil {
+		return workload{}, false, nil
+	}
+
+	kind := strings.ToLower(owner.Kind)
+	switch kind {
+	case k8s.StatefulSet, k8s.DaemonSet:
+		return workload{kind, pod.namespace, owner.Name}, true, nil
+	case k8s.ReplicaSet:
+		rs, err := hc.kubeAPI.AppsV1().ReplicaSets(pod.namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return workload{}, false, err
+		}
+		if rsOwner := metav1.GetControllerOf(rs); rsOwner != nil && strings.ToLower(rsOwner.Kind) == k8s.Deployment {
+			return workload{k8s.Deployment, pod.namespace, rsOwner.Name}, true, nil
+		}
+	}
+	return workload{}, false, nil
+}
+
+func (hc *HealthChecker) restartWorkload(ctx context.Context, w workload) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`,
+		restartedAtAnnotation, time.Now().Format(time.RFC3339)))
+	var err error
+	switch w.kind {
+	case k8s.Deployment:
+		_, err = hc.kubeAPI.AppsV1().Deployments(w.namespace).Patch(ctx, w.name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case k8s.StatefulSet:
+		_, err = hc.kubeAPI.AppsV1().StatefulSets(w.namespace).Patch(ctx, w.name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case k8s.DaemonSet:
+		_, err = hc.kubeAPI.AppsV1().DaemonSets(w.namespace).Patch(ctx, w.name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	default:
+		err = fmt.Errorf("unsupported kind %s", w.kind)
+	}
+	return err
+}
@@ -0,0 +1,154 @@
+package healthcheck
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	l5dcharts "github.com/linkerd/linkerd2/pkg/charts/linkerd2"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRestartWorkloadsFix(t *testing.T) {
+	hc := NewHealthChecker([]CategoryID{}, &Options{})
+	var err error
+	hc.kubeAPI, err = k8s.NewFakeAPI(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: emojivoto
+spec:
+  template:
+    metadata:
+      annotations:
+        linkerd.io/inject: enabled
+`, `
+apiVersion: apps/v1
+kind: ReplicaSet
+metadata:
+  name: web-5f86686c4d
+  namespace: emojivoto
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: web
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-5f86686c4d-58nkk
+  namespace: emojivoto
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: ReplicaSet
+    name: web-5f86686c4d
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-5f86686c4d-qv2xd
+  namespace: emojivoto
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: ReplicaSet
+    name: web-5f86686c4d
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: debug
+  namespace: emojivoto
+`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	t.Run("Restarts the workloads of the pods", func(t *testing.T) {
+		fix, err := hc.restartWorkloadsFix(context.Background(), []podRef{
+			{namespace: "emojivoto", name: "web-5f86686c4d-58nkk"},
+			{namespace: "emojivoto", name: "web-5f86686c4d-qv2xd"},
+			{namespace: "emojivoto", name: "debug"},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		expected := "restart deployment emojivoto/web; pods must be restarted manually: emojivoto/debug"
+		if fix.Description != expected {
+			t.Fatalf("Expected description %q, got %q", expected, fix.Description)
+		}
+
+		if err := fix.Apply(context.Background()); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		deploy, err := hc.kubeAPI.AppsV1().Deployments("emojivoto").Get(context.Background(), "web", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if _, ok := deploy.Spec.Template.Annotations[restartedAtAnnotation]; !ok {
+			t.Fatalf("Expected the deployment to be restarted, got annotations %v", deploy.Spec.Template.Annotations)
+		}
+	})
+
+	t.Run("Fails without workloads to restart", func(t *testing.T) {
+		_, err := hc.restartWorkloadsFix(context.Background(), []podRef{
+			{namespace: "emojivoto", name: "debug"},
+		})
+		expected := "no workload to restart; pods must be restarted manually: emojivoto/debug"
+		if err == nil || err.Error() != expected {
+			t.Fatalf("Expected error %q, got %v", expected, err)
+		}
+	})
+}
+
+func TestOutdatedPodsFix(t *testing.T) {
+	pods := []*pb.Pod{
+		{Name: "emojivoto/web-1", ProxyVersion: "stable-2.8.1"},
+		{Name: "emojivoto/emoji-1", ProxyVersion: "stable-2.9.0"},
+	}
+
+	testCases := []struct {
+		name     string
+		config   *l5dcharts.Values
+		expected []podRef
+	}{
+		{
+			"proxy version",
+			&l5dcharts.Values{Global: &l5dcharts.Global{
+				LinkerdVersion: "stable-2.9.1",
+				Proxy:          &l5dcharts.Proxy{Image: &l5dcharts.Image{Version: "stable-2.9.0"}},
+			}},
+			[]podRef{{namespace: "emojivoto", name: "web-1"}},
+		},
+		{
+			"control plane version",
+			&l5dcharts.Values{Global: &l5dcharts.Global{LinkerdVersion: "stable-2.8.1"}},
+			[]podRef{{namespace: "emojivoto", name: "emoji-1"}},
+		},
+		{
+			"server version",
+			nil,
+			[]podRef{{namespace: "emojivoto", name: "web-1"}, {namespace: "emojivoto", name: "emoji-1"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			hc := NewHealthChecker([]CategoryID{}, &Options{})
+			hc.linkerdConfig = tc.config
+			hc.serverVersion = "stable-2.9.1"
+
+			refs := podsNotRunningVersion(pods, hc.installedProxyVersion())
+			if !reflect.DeepEqual(refs, tc.expected) {
+				t.Fatalf("Expected pods %v, got %v", tc.expected, refs)
+			}
+		})
+	}
+}
@@ -32,7 +32,9 @@ const (
 func (hc *HealthChecker) multiClusterCategory() []category {
 	return []category{
 		{
-			id: LinkerdMulticlusterChecks,
+			id:        LinkerdMulticlusterChecks,
+			populates: []state{linksState},
+			reads:     []state{kubeAPIState, linkerdConfigState, apiClientState},
 			checkers: []checker{
 				/* Link checks */
 				{
@@ -0,0 +1,213 @@
+package healthcheck
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// CheckPluginPrefix is the file name prefix of the executables that
+// FindCheckPlugins discovers. The rest of the file name is the name of the
+// plugin, used as its category. A category reported by the plugin is
+// namespaced by the name of the plugin, e.g. acme/annotations.
+const CheckPluginPrefix = "linkerd-check-"
+
+// pluginOutput is what a check plugin prints to stdout. It has the same shape
+// as a category of `linkerd check -o json`.
+type pluginOutput struct {
+	Name   string         `json:"categoryName"`
+	Checks []*pluginCheck `json:"checks"`
+}
+
+type pluginCheck struct {
+	Description string `json:"description"`
+	Hint        string `json:"hint"`
+	Error       string `json:"error"`
+	Result      string `json:"result"`
+}
+
+// FindCheckPlugins returns the check plugin executables found in the
+// directories of path, a list in the format of the PATH environment variable.
+// As with PATH lookups, a plugin shadows those with the same name in later
+// directories. Plugins of the same directory are sorted by name.
+func FindCheckPlugins(path string) []string {
+	plugins := []string{}
+	seen := map[string]bool{}
+	for _, dir := range filepath.SplitList(path) {
+		if dir == "" {
+			continue
+		}
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		sort.Slice(files, func(i, j int) bool { return files[i].Name() < files[j].Name() })
+		for _, file := range files {
+			name := checkPluginName(file.Name())
+			if name == "" || seen[name] {
+				continue
+			}
+			// Stat the file, as plugins are often installed as symlinks.
+			plugin := filepath.Join(dir, file.Name())
+			info, err := os.Stat(plugin)
+			if err != nil || !isExecutable(info) {
+				continue
+			}
+			seen[name] = true
+			plugins = append(plugins, plugin)
+		}
+	}
+	return plugins
+}
+
+// AddCheckPlugins adds a category for each of the check plugin executables,
+// run after the categories the HealthChecker was created with. An error is
+// returned if the name of a plugin is the ID of another category.
+func (hc *HealthChecker) AddCheckPlugins(plugins ...string) error {
+	ids := map[CategoryID]bool{}
+	for _, c := range hc.categories {
+		ids[c.id] = true
+	}
+
+	for _, plugin := range plugins {
+		plugin := plugin // pin
+		name := checkPluginName(filepath.Base(plugin))
+		if name == "" {
+			name = filepath.Base(plugin)
+		}
+		if ids[CategoryID(name)] {
+			return fmt.Errorf("check plugin %s has the name of the %s category", plugin, name)
+		}
+		ids[CategoryID(name)] = true
+		hc.addCategory(category{
+			id: CategoryID(name),
+			checkers: []checker{
+				{
+					description: fmt.Sprintf("check plugin %s runs successfully", name),
+					checkPlugin: func(ctx context.Context) (*pluginOutput, error) {
+						return hc.runPlugin(ctx, plugin)
+					},
+				},
+			},
+		})
+	}
+	return nil
+}
+
+// runPlugin executes a check plugin, passing it the options of the
+// HealthChecker through its environment, and decodes the checks it reports.
+// A plugin may exit with a non-zero status as long as it reports its checks.
+func (hc *HealthChecker) runPlugin(ctx context.Context, plugin string) (*pluginOutput, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, plugin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("LINKERD_NAMESPACE=%s", hc.ControlPlaneNamespace),
+		fmt.Sprintf("LINKERD_DATA_PLANE_NAMESPACE=%s", hc.DataPlaneNamespace),
+		fmt.Sprintf("LINKERD_KUBE_CONTEXT=%s", hc.KubeContext),
+	)
+	if hc.KubeConfig != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("KUBECONFIG=%s", hc.KubeConfig))
+	}
+	runErr := cmd.Run()
+
+	var output pluginOutput
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil || output.Checks == nil {
+		if runErr != nil {
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				return nil, fmt.Errorf("%s: %s", runErr, msg)
+			}
+			return nil, runErr
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid plugin output: %s", err)
+		}
+		return nil, errors.New("invalid plugin output: no checks reported")
+	}
+	return &output, nil
+}
+
+// runCheckPlugin calls `c`, which runs a check plugin, and sends each of the
+// checks the plugin reports to `observer`. If the plugin can't be run or its
+// output can't be understood, that is reported as the failure of `c` itself.
+// Plugins are not retried; they are expected to wait for their own checks.
+func (hc *HealthChecker) runCheckPlugin(ctx context.Context, categoryID CategoryID, c *checker, observer CheckObserver) bool {
+	checkCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	output, err := c.checkPlugin(checkCtx)
+	if err != nil {
+		observer(&CheckResult{
+			Category:    categoryID,
+			Description: c.description,
+			HintAnchor:  c.hintAnchor,
+			Err:         &CategoryError{categoryID, err},
+		})
+		return false
+	}
+
+	if output.Name != "" && CategoryID(output.Name) != categoryID {
+		categoryID = CategoryID(fmt.Sprintf("%s/%s", categoryID, output.Name))
+	}
+	success := true
+	for _, check := range output.Checks {
+		checkResult := &CheckResult{
+			Category:    categoryID,
+			Description: check.Description,
+			HintAnchor:  check.Hint,
+		}
+		switch check.Result {
+		case "success":
+		case "warning":
+			checkResult.Warning = true
+			checkResult.Err = &CategoryError{categoryID, pluginCheckError(check)}
+		case "error":
+			checkResult.Err = &CategoryError{categoryID, pluginCheckError(check)}
+			success = false
+		default:
+			checkResult.Err = &CategoryError{categoryID, fmt.Errorf("unknown check result %q", check.Result)}
+			success = false
+		}
+		observer(checkResult)
+	}
+	return success
+}
+
+func pluginCheckError(check *pluginCheck) error {
+	if check.Error == "" {
+		return errors.New("check failed")
+	}
+	return errors.New(check.Error)
+}
+
+// checkPluginName returns the name of the check plugin with the given file
+// name, or "" if it is not a check plugin.
+func checkPluginName(file string) string {
+	if !strings.HasPrefix(file, CheckPluginPrefix) {
+		return ""
+	}
+	name := strings.TrimPrefix(file, CheckPluginPrefix)
+	if runtime.GOOS == "windows" {
+		name = strings.TrimSuffix(name, ".exe")
+	}
+	return name
+}
+
+func isExecutable(info os.FileInfo) bool {
+	if !info.Mode().IsRegular() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return strings.HasSuffix(info.Name(), ".exe")
+	}
+	return info.Mode()&0111 != 0
+}
@@ -0,0 +1,156 @@
+package healthcheck
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func writePlugin(t *testing.T, dir, name, script string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte("#!/bin/sh\n"+script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestFindCheckPlugins(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("check plugins are shell scripts")
+	}
+
+	dir1, err := ioutil.TempDir("", "plugins")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir1)
+	dir2, err := ioutil.TempDir("", "plugins")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir2)
+
+	b := writePlugin(t, dir1, "linkerd-check-b", "")
+	a := writePlugin(t, dir1, "linkerd-check-a", "")
+	writePlugin(t, dir1, "linkerd-other", "")
+	if err := ioutil.WriteFile(filepath.Join(dir1, "linkerd-check-not-executable"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	writePlugin(t, dir2, "linkerd-check-a", "")
+	c := writePlugin(t, dir2, "linkerd-check-c", "")
+
+	plugins := FindCheckPlugins(filepath.Join(dir1, "missing") + string(filepath.ListSeparator) + dir1 + string(filepath.ListSeparator) + dir2)
+	expected := []string{a, b, c}
+	if !reflect.DeepEqual(plugins, expected) {
+		t.Fatalf("Expected plugins %v, got %v", expected, plugins)
+	}
+}
+
+func TestCheckPlugins(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("check plugins are shell scripts")
+	}
+
+	dir, err := ioutil.TempDir("", "plugins")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testCases := []struct {
+		name     string
+		script   string
+		success  bool
+		expected []string
+	}{
+		{
+			"reported-checks",
+			`cat <<EOF
+{
+  "checks": [
+    {"description": "namespaces are labelled", "result": "success"},
+    {"description": "pods have limits", "result": "warning", "error": "web has no limits in $LINKERD_DATA_PLANE_NAMESPACE", "hint": "https://wiki.acme.org/limits"}
+  ]
+}
+EOF`,
+			true,
+			[]string{
+				"reported-checks namespaces are labelled",
+				"reported-checks pods have limits: web has no limits in emojivoto",
+			},
+		},
+		{
+			"reported-category",
+			`echo '{"categoryName": "acme", "checks": [{"description": "annotations are set", "result": "error"}]}'; exit 1`,
+			false,
+			[]string{
+				"reported-category/acme annotations are set: check failed",
+			},
+		},
+		{
+			"failing",
+			`echo "no cluster access" >&2; exit 2`,
+			false,
+			[]string{
+				"failing check plugin failing runs successfully: exit status 2: no cluster access",
+			},
+		},
+		{
+			"invalid",
+			`echo "all good"`,
+			false,
+			[]string{
+				"invalid check plugin invalid runs successfully: invalid plugin output: invalid character 'a' looking for beginning of value",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := writePlugin(t, dir, CheckPluginPrefix+tc.name, tc.script)
+
+			hc := NewHealthChecker([]CategoryID{}, &Options{DataPlaneNamespace: "emojivoto"})
+			if err := hc.AddCheckPlugins(plugin); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			obs := newObserver()
+			success := hc.RunChecks(obs.resultFn)
+
+			if success != tc.success {
+				t.Fatalf("Expected success %t, got %t", tc.success, success)
+			}
+			if !reflect.DeepEqual(obs.results, tc.expected) {
+				t.Fatalf("Expected results %v, but got %v", tc.expected, obs.results)
+			}
+		})
+	}
+}
+
+func TestCheckPluginsCollision(t *testing.T) {
+	dir, err := ioutil.TempDir("", "check-plugins")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	plugin := writePlugin(t, dir, CheckPluginPrefix+string(LinkerdConfigChecks), `echo '{"checks": []}'`)
+	hc := NewHealthChecker([]CategoryID{}, &Options{})
+	if err := hc.AddCheckPlugins(plugin); err == nil {
+		t.Fatalf("Expected an error for a plugin named after the %s category", LinkerdConfigChecks)
+	}
+}
+
+func TestHintURL(t *testing.T) {
+	if url := HintURL("l5d-data-plane"); url != "https://linkerd.io/checks/#l5d-data-plane" {
+		t.Fatalf("Unexpected hint URL %s", url)
+	}
+	if url := HintURL("https://wiki.acme.org/limits"); url != "https://wiki.acme.org/limits" {
+		t.Fatalf("Unexpected hint URL %s", url)
+	}
+}
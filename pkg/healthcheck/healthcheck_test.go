@@ -26,7 +26,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 )
 
 type observer struct {
@@ -376,6 +379,170 @@ func TestHealthChecker(t *testing.T) {
 	})
 }
 
+func TestRunChecksConcurrently(t *testing.T) {
+	t.Run("Categories declare the state they populate and read", func(t *testing.T) {
+		hc := NewHealthChecker([]CategoryID{}, &Options{})
+		for _, c := range hc.categories {
+			if len(c.populates) == 0 && len(c.reads) == 0 {
+				t.Errorf("Category %s declares no state, so it can't run concurrently", c.id)
+			}
+		}
+	})
+
+	t.Run("Runs the CNI checks after CNIEnabled is read from linkerd-config", func(t *testing.T) {
+		hc := NewHealthChecker([]CategoryID{}, &Options{})
+		categories := map[CategoryID]category{}
+		for _, c := range hc.categories {
+			categories[c.id] = c
+		}
+		if !dependsOn(categories[LinkerdCNIPluginChecks], categories[LinkerdControlPlaneExistenceChecks]) {
+			t.Fatalf("Expected the %s category to wait for the %s category", LinkerdCNIPluginChecks, LinkerdControlPlaneExistenceChecks)
+		}
+	})
+
+	t.Run("Runs independent categories concurrently, observing results in order", func(t *testing.T) {
+		// cat1 can only pass once cat2 has run, which it would never do if
+		// categories were run one after the other.
+		cat2Ran := make(chan struct{})
+		hc := NewHealthChecker([]CategoryID{}, &Options{})
+		hc.addCategory(category{
+			id:    "cat1",
+			reads: []state{kubeAPIState},
+			checkers: []checker{
+				{
+					description: "desc1",
+					check: func(context.Context) error {
+						select {
+						case <-cat2Ran:
+							return nil
+						case <-time.After(5 * time.Second):
+							return errors.New("cat2 didn't run")
+						}
+					},
+				},
+			},
+		})
+		hc.addCategory(category{
+			id:    "cat2",
+			reads: []state{kubeAPIState},
+			checkers: []checker{
+				{
+					description: "desc2",
+					check: func(context.Context) error {
+						close(cat2Ran)
+						return nil
+					},
+				},
+			},
+		})
+
+		obs := newObserver()
+		success := hc.RunChecks(obs.resultFn)
+
+		expectedResults := []string{"cat1 desc1", "cat2 desc2"}
+		if !success || !reflect.DeepEqual(obs.results, expectedResults) {
+			t.Fatalf("Expected success with results %v, but got %t with %v", expectedResults, success, obs.results)
+		}
+	})
+
+	t.Run("Runs categories after the categories populating the state they read", func(t *testing.T) {
+		populated := false
+		hc := NewHealthChecker([]CategoryID{}, &Options{})
+		hc.addCategory(category{
+			id:        "cat1",
+			populates: []state{linkerdConfigState},
+			checkers: []checker{
+				{
+					description: "desc1",
+					check: func(context.Context) error {
+						time.Sleep(100 * time.Millisecond)
+						populated = true
+						return nil
+					},
+				},
+			},
+		})
+		hc.addCategory(category{
+			id:    "cat2",
+			reads: []state{linkerdConfigState},
+			checkers: []checker{
+				{
+					description: "desc2",
+					check: func(context.Context) error {
+						if !populated {
+							return errors.New("not populated")
+						}
+						return nil
+					},
+				},
+			},
+		})
+
+		obs := newObserver()
+		success := hc.RunChecks(obs.resultFn)
+
+		expectedResults := []string{"cat1 desc1", "cat2 desc2"}
+		if !success || !reflect.DeepEqual(obs.results, expectedResults) {
+			t.Fatalf("Expected success with results %v, but got %t with %v", expectedResults, success, obs.results)
+		}
+	})
+
+	t.Run("Stops after a fatal failure", func(t *testing.T) {
+		dependentRan := false
+		hc := NewHealthChecker([]CategoryID{}, &Options{})
+		hc.addCategory(category{
+			id:        "cat1",
+			populates: []state{kubeAPIState},
+			checkers: []checker{
+				{
+					description: "desc1",
+					fatal:       true,
+					check: func(context.Context) error {
+						time.Sleep(100 * time.Millisecond)
+						return errors.New("error")
+					},
+				},
+			},
+		})
+		hc.addCategory(category{
+			id:    "cat2",
+			reads: []state{linkerdConfigState},
+			checkers: []checker{
+				{
+					description: "desc2",
+					check: func(context.Context) error {
+						return nil
+					},
+				},
+			},
+		})
+		hc.addCategory(category{
+			id:    "cat3",
+			reads: []state{kubeAPIState},
+			checkers: []checker{
+				{
+					description: "desc3",
+					check: func(context.Context) error {
+						dependentRan = true
+						return nil
+					},
+				},
+			},
+		})
+
+		obs := newObserver()
+		success := hc.RunChecks(obs.resultFn)
+
+		expectedResults := []string{"cat1 desc1: error"}
+		if success || !reflect.DeepEqual(obs.results, expectedResults) {
+			t.Fatalf("Expected failure with results %v, but got %t with %v", expectedResults, success, obs.results)
+		}
+		if dependentRan {
+			t.Fatal("Expected the category depending on the fatal failure not to run")
+		}
+	})
+}
+
 func TestCheckCanCreate(t *testing.T) {
 	exp := fmt.Errorf("not authorized to access deployments.apps")
 
@@ -834,7 +1001,7 @@ metadata:
 				"linkerd-config control plane ClusterRoles exist",
 				"linkerd-config control plane ClusterRoleBindings exist",
 				"linkerd-config control plane ServiceAccounts exist",
-				"linkerd-config control plane CustomResourceDefinitions exist: missing CustomResourceDefinitions: serviceprofiles.linkerd.io",
+				"linkerd-config control plane CustomResourceDefinitions exist: missing CustomResourceDefinitions: externalworkloads.workload.linkerd.io, identitydenylists.identity.linkerd.io, serverauthorizations.policy.linkerd.io, serviceprofiles.linkerd.io",
 			},
 		},
 		{
@@ -1037,6 +1204,30 @@ metadata:
   name: serviceprofiles.linkerd.io
   labels:
     linkerd.io/control-plane-ns: test-ns
+`,
+				`
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: identitydenylists.identity.linkerd.io
+  labels:
+    linkerd.io/control-plane-ns: test-ns
+`,
+				`
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: externalworkloads.workload.linkerd.io
+  labels:
+    linkerd.io/control-plane-ns: test-ns
+`,
+				`
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: serverauthorizations.policy.linkerd.io
+  labels:
+    linkerd.io/control-plane-ns: test-ns
 `,
 			},
 			[]string{
@@ -1250,6 +1441,30 @@ metadata:
     linkerd.io/control-plane-ns: test-ns
 `,
 				`
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: identitydenylists.identity.linkerd.io
+  labels:
+    linkerd.io/control-plane-ns: test-ns
+`,
+				`
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: externalworkloads.workload.linkerd.io
+  labels:
+    linkerd.io/control-plane-ns: test-ns
+`,
+				`
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: serverauthorizations.policy.linkerd.io
+  labels:
+    linkerd.io/control-plane-ns: test-ns
+`,
+				`
 apiVersion: admissionregistration.k8s.io/v1beta1
 kind: MutatingWebhookConfiguration
 metadata:
@@ -1470,6 +1685,30 @@ metadata:
     linkerd.io/control-plane-ns: test-ns
 `,
 				`
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: identitydenylists.identity.linkerd.io
+  labels:
+    linkerd.io/control-plane-ns: test-ns
+`,
+				`
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: externalworkloads.workload.linkerd.io
+  labels:
+    linkerd.io/control-plane-ns: test-ns
+`,
+				`
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: serverauthorizations.policy.linkerd.io
+  labels:
+    linkerd.io/control-plane-ns: test-ns
+`,
+				`
 apiVersion: admissionregistration.k8s.io/v1beta1
 kind: MutatingWebhookConfiguration
 metadata:
@@ -1699,6 +1938,30 @@ metadata:
     linkerd.io/control-plane-ns: test-ns
 `,
 				`
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: identitydenylists.identity.linkerd.io
+  labels:
+    linkerd.io/control-plane-ns: test-ns
+`,
+				`
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: externalworkloads.workload.linkerd.io
+  labels:
+    linkerd.io/control-plane-ns: test-ns
+`,
+				`
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: serverauthorizations.policy.linkerd.io
+  labels:
+    linkerd.io/control-plane-ns: test-ns
+`,
+				`
 apiVersion: admissionregistration.k8s.io/v1beta1
 kind: MutatingWebhookConfiguration
 metadata:
@@ -3039,7 +3302,7 @@ func TestLinkerdIdentityCheckCertValidity(t *testing.T) {
 				starts: time.Date(2100, 1, 1, 1, 1, 1, 1, time.UTC),
 				ends:   time.Date(2101, 1, 1, 1, 1, 1, 1, time.UTC),
 			},
-			expectedOutput: []string{"linkerd-identity-test-cat trust anchors are within their validity period: Invalid anchors:\n\t* 1 identity.linkerd.cluster.local not valid before: 2100-01-01T01:00:51Z"},
+			expectedOutput: []string{"linkerd-identity-test-cat trust anchors are within their validity period: Invalid anchors:\n\t* {serial} identity.linkerd.cluster.local not valid before: 2100-01-01T01:00:51Z"},
 		},
 		{
 			checkerToTest:    "trust anchors are within their validity period",
@@ -3048,7 +3311,7 @@ func TestLinkerdIdentityCheckCertValidity(t *testing.T) {
 				starts: time.Date(1989, 1, 1, 1, 1, 1, 1, time.UTC),
 				ends:   time.Date(1990, 1, 1, 1, 1, 1, 1, time.UTC),
 			},
-			expectedOutput: []string{"linkerd-identity-test-cat trust anchors are within their validity period: Invalid anchors:\n\t* 1 identity.linkerd.cluster.local not valid anymore. Expired on 1990-01-01T01:01:11Z"},
+			expectedOutput: []string{"linkerd-identity-test-cat trust anchors are within their validity period: Invalid anchors:\n\t* {serial} identity.linkerd.cluster.local not valid anymore. Expired on 1990-01-01T01:01:11Z"},
 		},
 		{
 			checkerToTest:    "issuer cert is within its validity period",
@@ -3075,7 +3338,18 @@ func TestLinkerdIdentityCheckCertValidity(t *testing.T) {
 		issuerData := createIssuerData("identity.linkerd.cluster.local", testCase.lifespan.starts, testCase.lifespan.ends)
 		fakeConfigMap := getFakeConfigMap(k8s.IdentityIssuerSchemeLinkerd, issuerData)
 		fakeSecret := getFakeSecret(k8s.IdentityIssuerSchemeLinkerd, issuerData)
-		runIdentityCheckTestCase(context.Background(), t, id, testCase.checkDescription, testCase.checkerToTest, fakeConfigMap, fakeSecret, testCase.expectedOutput)
+
+		// serial numbers are random, so they're filled in once the anchor has
+		// been generated
+		anchors, err := tls.DecodePEMCertificates(issuerData.TrustAnchors)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		expectedOutput := make([]string, len(testCase.expectedOutput))
+		for i, output := range testCase.expectedOutput {
+			expectedOutput[i] = strings.ReplaceAll(output, "{serial}", anchors[0].SerialNumber.String())
+		}
+		runIdentityCheckTestCase(context.Background(), t, id, testCase.checkDescription, testCase.checkerToTest, fakeConfigMap, fakeSecret, expectedOutput)
 	}
 }
 
@@ -3088,6 +3362,64 @@ func TestLinkerdIdentityCheckWrongDns(t *testing.T) {
 
 }
 
+func TestCheckIdentityDenyLists(t *testing.T) {
+	denyList := func(name string, spec map[string]interface{}) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": k8s.IdentityDenyListAPIGroupVersion,
+			"kind":       k8s.IdentityDenyListKind,
+			"metadata":   map[string]interface{}{"name": name},
+			"spec":       spec,
+		}}
+	}
+
+	testCases := []struct {
+		name          string
+		objects       []runtime.Object
+		expectedError string
+	}{
+		{
+			name: "valid deny-lists",
+			objects: []runtime.Object{
+				denyList("compromised", map[string]interface{}{
+					"identities":    []interface{}{"web.emojivoto.serviceaccount.identity.linkerd.cluster.local"},
+					"serialNumbers": []interface{}{"5c:0f:3e"},
+				}),
+			},
+		},
+		{
+			name: "invalid deny-lists",
+			objects: []runtime.Object{
+				denyList("bad-identity", map[string]interface{}{
+					"identities": []interface{}{"Web_Emojivoto"},
+				}),
+				denyList("bad-serial", map[string]interface{}{
+					"serialNumbers": []interface{}{"not-hex"},
+				}),
+			},
+			expectedError: "invalid identity deny-lists:\n\t" +
+				"bad-identity: invalid identity 'Web_Emojivoto': a DNS-1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')\n\t" +
+				"bad-serial: invalid serial number \"not-hex\": expected a hex-encoded number",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), tc.objects...)
+			err := CheckIdentityDenyLists(context.Background(), client)
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expectedError {
+				t.Fatalf("Expected error:\n%s\ngot:\n%v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
 type fakeCniResourcesOpts struct {
 	hasConfigMap          bool
 	hasPodSecurityPolicy  bool
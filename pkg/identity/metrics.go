@@ -0,0 +1,44 @@
+package identity
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	labelOutcome = "outcome"
+
+	outcomeSuccess          = "success"
+	outcomeIssuerNotReady   = "issuer_not_ready"
+	outcomeIssuerExpired    = "issuer_expired"
+	outcomeInvalidRequest   = "invalid_request"
+	outcomeInvalidToken     = "invalid_token"
+	outcomeNotAuthenticated = "not_authenticated"
+	outcomeIdentityMismatch = "identity_mismatch"
+	outcomeDenied           = "denied"
+	outcomeError            = "error"
+)
+
+var (
+	certifyRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "identity_cert_issuance_total",
+		Help: "A counter for the number of certificate requests handled by the identity service, by outcome.",
+	}, []string{labelOutcome})
+
+	certifyLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "identity_cert_issuance_duration_seconds",
+		Help: "A histogram of the time taken by the identity service to handle certificate requests, including token validation, by outcome.",
+		Buckets: []float64{
+			0.001, 0.002, 0.005,
+			0.01, 0.02, 0.05,
+			0.1, 0.2, 0.5,
+			1, 2, 5,
+			10,
+		},
+	}, []string{labelOutcome})
+
+	issuerExpiry = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "identity_issuer_expiry_timestamp_seconds",
+		Help: "The time at which the issuer certificate the identity service signs with expires, in seconds since the Unix epoch.",
+	})
+)
@@ -1,10 +1,13 @@
 package identity
 
 import (
+	"bytes"
 	"context"
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"math/big"
+	"net/url"
 	"sync"
 	"time"
 
@@ -42,6 +45,42 @@ type (
 		validity                                   *tls.Validity
 		recordEvent                                func(eventType, reason, message string)
 		expectedName, issuerPathCrt, issuerPathKey string
+		external                                   ExternalIssuer
+		denyList                                   DenyList
+		spiffeIDs                                  SpiffeIDs
+		reloadInterval                             time.Duration
+	}
+
+	// DenyList is implemented by sources of identities and certificates the
+	// service must refuse to certify, e.g. because they were compromised.
+	DenyList interface {
+		// DeniedIdentity returns the name of the deny-list denying the given
+		// identity, if any.
+		DeniedIdentity(identity string) (string, bool)
+
+		// DeniedSerialNumber returns the name of the deny-list denying the
+		// certificate with the given serial number, if any.
+		DeniedSerialNumber(serial *big.Int) (string, bool)
+	}
+
+	// ExternalIssuer is implemented by issuers that forward CSRs to a signer
+	// outside of the identity controller, e.g. Vault or cert-manager. The
+	// issuer's credentials aren't available to the service, so the validity
+	// of the chain is checked on each issued certificate instead.
+	ExternalIssuer interface {
+		tls.Issuer
+
+		// Name describes the external signer, for logs and events.
+		Name() string
+	}
+
+	// SpiffeIDs is implemented by trust domains, which issue SPIFFE IDs
+	// alongside the DNS-form identities of service accounts.
+	SpiffeIDs interface {
+		// IdentitySpiffeID returns the SPIFFE ID issued alongside a DNS-form
+		// identity. An error is returned for identities that don't name a
+		// service account of the trust domain.
+		IdentitySpiffeID(identity string) (*url.URL, error)
 	}
 
 	// Validator implementors accept a bearer token, validates it, and returns a
@@ -65,10 +104,30 @@ type (
 	// NotAuthenticated is an error type returned by Validators to indicate that the
 	// provided authentication token could not be authenticated.
 	NotAuthenticated struct{}
+
+	csrKey struct{}
 )
 
+// WithCSR returns a copy of ctx carrying the CSR a token is validated for, so
+// that Validators can bind tokens to the requester's key.
+func WithCSR(ctx context.Context, csr *x509.CertificateRequest) context.Context {
+	return context.WithValue(ctx, csrKey{}, csr)
+}
+
+// CSRFromContext returns the CSR a token is validated for, if any.
+func CSRFromContext(ctx context.Context) (*x509.CertificateRequest, bool) {
+	csr, ok := ctx.Value(csrKey{}).(*x509.CertificateRequest)
+	return csr, ok
+}
+
 // Initialize loads the issuer certs from disk so it can start service CSRs to proxies
 func (svc *Service) Initialize() error {
+	if svc.external != nil {
+		log.Infof("Forwarding CSRs to %s", svc.external.Name())
+		svc.updateIssuer(svc.external)
+		return nil
+	}
+
 	credentials, err := svc.loadCredentials()
 	if err != nil {
 		return err
@@ -82,10 +141,23 @@ func (svc *Service) updateIssuer(newIssuer tls.Issuer) {
 	svc.issuer = &newIssuer
 	log.Debug("Issuer has been updated")
 	svc.issuerMutex.Unlock()
+
+	if ca, ok := newIssuer.(*tls.CA); ok {
+		issuerExpiry.Set(float64(ca.Cred.Certificate.NotAfter.Unix()))
+	}
 }
 
 // Run reads from the issuer and error channels and reloads the issuer certs when necessary
 func (svc *Service) Run(issuerEvent <-chan struct{}, issuerError <-chan error) {
+	// Credentials read from disk are also re-read periodically, in case
+	// fs-watch events were missed.
+	var reload <-chan time.Time
+	if svc.external == nil && svc.reloadInterval > 0 {
+		ticker := time.NewTicker(svc.reloadInterval)
+		defer ticker.Stop()
+		reload = ticker.C
+	}
+
 	for {
 		select {
 		case <-issuerEvent:
@@ -98,12 +170,70 @@ func (svc *Service) Run(issuerEvent <-chan struct{}, issuerError <-chan error) {
 				log.Infof(message)
 				svc.recordEvent(v1.EventTypeNormal, eventTypeUpdated, message)
 			}
+		case <-reload:
+			svc.reloadIssuer()
 		case err := <-issuerError:
 			log.Warnf("Received error from fs watcher: %s", err)
 		}
 	}
 }
 
+// reloadIssuer re-reads the issuer credentials from disk, and updates the
+// issuer if they changed since they were last loaded.
+func (svc *Service) reloadIssuer() {
+	credentials, err := svc.loadCredentials()
+	if err != nil {
+		log.Warnf("Failed to reload issuer certs from disk: %s", err)
+		return
+	}
+
+	if current, ok := svc.currentIssuer().(*tls.CA); ok {
+		if bytes.Equal(current.Cred.Certificate.Raw, credentials.(*tls.CA).Cred.Certificate.Raw) {
+			return
+		}
+	}
+
+	svc.updateIssuer(credentials)
+	message := "Updated identity issuer after periodic reload"
+	log.Infof(message)
+	svc.recordEvent(v1.EventTypeNormal, eventTypeUpdated, message)
+}
+
+// Ready returns an error when the service can't certify proxies, because its
+// issuer hasn't been loaded or isn't valid anymore, e.g. because it expired.
+func (svc *Service) Ready() error {
+	issuer := svc.currentIssuer()
+	if issuer == nil {
+		return errors.New("cert issuer not ready yet")
+	}
+	if err := svc.ensureIssuerStillValid(issuer); err != nil {
+		return fmt.Errorf("cert issuer is invalid: %s", err)
+	}
+	return nil
+}
+
+// SetReloadInterval configures the service to re-read the issuer credentials
+// from disk at the given interval, in addition to when they are reported to
+// have changed. It must be called before the service is run.
+func (svc *Service) SetReloadInterval(interval time.Duration) {
+	svc.reloadInterval = interval
+}
+
+// SetDenyList configures the service to refuse certifying the identities and
+// certificates denied by d. It must be called before the service is
+// registered.
+func (svc *Service) SetDenyList(d DenyList) {
+	svc.denyList = d
+}
+
+// SetSpiffeIDs configures the service to issue the SPIFFE IDs of s alongside
+// the identities of service accounts. Without it, no SPIFFE ID is issued and
+// CSRs requesting URI SANs are rejected. It must be called before the service
+// is registered.
+func (svc *Service) SetSpiffeIDs(s SpiffeIDs) {
+	svc.spiffeIDs = s
+}
+
 func (svc *Service) loadCredentials() (tls.Issuer, error) {
 	creds, err := tls.ReadPEMCreds(
 		svc.issuerPathKey,
@@ -134,22 +264,49 @@ func NewService(validator Validator, trustAnchors *x509.CertPool, validity *tls.
 		expectedName,
 		issuerPathCrt,
 		issuerPathKey,
+		nil,
+		nil,
+		nil,
+		0,
 	}
 }
 
+// NewExternalService creates a new identity service that has certificates
+// signed by an external issuer, rather than by issuer credentials read from
+// disk.
+func NewExternalService(validator Validator, trustAnchors *x509.CertPool, validity *tls.Validity, recordEvent func(eventType, reason, message string), expectedName string, issuer ExternalIssuer) *Service {
+	svc := NewService(validator, trustAnchors, validity, recordEvent, expectedName, "", "")
+	svc.external = issuer
+	return svc
+}
+
 // Register registers an identity service implementation in the provided gRPC
 // server.
 func Register(g *grpc.Server, s *Service) {
 	pb.RegisterIdentityServer(g, s)
 }
 
+// currentIssuer returns the issuer certificates are currently signed with, or
+// nil if it hasn't been loaded yet. The issuer lock is only held while the
+// issuer is looked up, so that requests are certified in parallel and an
+// issuer update doesn't wait for in-flight requests.
+func (svc *Service) currentIssuer() tls.Issuer {
+	svc.issuerMutex.RLock()
+	defer svc.issuerMutex.RUnlock()
+	if svc.issuer == nil {
+		return nil
+	}
+	return *svc.issuer
+}
+
 // ensureIssuerStillValid should check that the CA is still good time wise
 // and verifies just fine with the provided trust anchors
-func (svc *Service) ensureIssuerStillValid() error {
-	issuer := *svc.issuer
+func (svc *Service) ensureIssuerStillValid(issuer tls.Issuer) error {
 	switch is := issuer.(type) {
 	case *tls.CA:
 		return is.Cred.Verify(svc.trustAnchors, svc.expectedName, time.Time{})
+	case ExternalIssuer:
+		return nil
 	default:
 		return fmt.Errorf("unsupported issuer type. Expected *tls.CA, got %v", is)
 	}
@@ -157,47 +314,55 @@ func (svc *Service) ensureIssuerStillValid() error {
 
 // Certify validates identity and signs certificates.
 func (svc *Service) Certify(ctx context.Context, req *pb.CertifyRequest) (*pb.CertifyResponse, error) {
-	svc.issuerMutex.RLock()
-	defer svc.issuerMutex.RUnlock()
+	start := time.Now()
+	rsp, outcome, err := svc.certify(ctx, req)
+	certifyRequests.WithLabelValues(outcome).Inc()
+	certifyLatency.WithLabelValues(outcome).Observe(time.Since(start).Seconds())
+	return rsp, err
+}
 
-	if svc.issuer == nil {
+// certify handles a certificate request, returning the outcome it's recorded
+// under in the service's metrics.
+func (svc *Service) certify(ctx context.Context, req *pb.CertifyRequest) (*pb.CertifyResponse, string, error) {
+	issuer := svc.currentIssuer()
+	if issuer == nil {
 		log.Warn("Certificate issuer is not ready")
-		return nil, status.Error(codes.Unavailable, "cert issuer not ready yet")
+		return nil, outcomeIssuerNotReady, status.Error(codes.Unavailable, "cert issuer not ready yet")
 	}
 
 	// Extract the relevant info from the request.
 	reqIdentity, tok, csr, err := checkRequest(req)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, outcomeInvalidRequest, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := svc.ensureIssuerStillValid(); err != nil {
+	if err := svc.ensureIssuerStillValid(issuer); err != nil {
 		log.Errorf("could not process CSR because of CA cert validation failure: %s - CSR Identity : %s", err, reqIdentity)
 		message := fmt.Sprintf("%s - CSR Identity : %s", err.Error(), reqIdentity)
 		svc.recordEvent(v1.EventTypeWarning, eventTypeFailed, message)
-		return nil, err
+		return nil, outcomeIssuerExpired, err
 	}
 
-	if err = checkCSR(csr, reqIdentity); err != nil {
+	if err = checkCSR(csr, reqIdentity, svc.spiffeIDs); err != nil {
 		log.Debugf("requester sent invalid CSR: %s", err)
-		return nil, status.Error(codes.FailedPrecondition, err.Error())
+		return nil, outcomeInvalidRequest, status.Error(codes.FailedPrecondition, err.Error())
 	}
 
 	// Authenticate the provided token against the Kubernetes API.
 	log.Debugf("Validating token for %s", reqIdentity)
-	tokIdentity, err := svc.validator.Validate(ctx, tok)
+	tokIdentity, err := svc.validator.Validate(WithCSR(ctx, csr), tok)
 	if err != nil {
 		switch e := err.(type) {
 		case NotAuthenticated:
 			log.Infof("authentication failed for %s: %s", reqIdentity, e)
-			return nil, status.Error(codes.FailedPrecondition, e.Error())
+			return nil, outcomeNotAuthenticated, status.Error(codes.FailedPrecondition, e.Error())
 		case InvalidToken:
 			log.Debugf("invalid token provided for %s: %s", reqIdentity, e)
-			return nil, status.Error(codes.InvalidArgument, e.Error())
+			return nil, outcomeInvalidToken, status.Error(codes.InvalidArgument, e.Error())
 		default:
 			msg := fmt.Sprintf("error validating token for %s: %s", reqIdentity, e)
 			log.Error(msg)
-			return nil, status.Error(codes.Internal, msg)
+			return nil, outcomeError, status.Error(codes.Internal, msg)
 		}
 	}
 
@@ -206,14 +371,35 @@ func (svc *Service) Certify(ctx context.Context, req *pb.CertifyRequest) (*pb.Ce
 		msg := fmt.Sprintf("requested identity did not match provided token: requested=%s; found=%s",
 			reqIdentity, tokIdentity)
 		log.Debug(msg)
-		return nil, status.Error(codes.FailedPrecondition, msg)
+		return nil, outcomeIdentityMismatch, status.Error(codes.FailedPrecondition, msg)
+	}
+
+	if svc.denyList != nil {
+		if name, ok := svc.denyList.DeniedIdentity(tokIdentity); ok {
+			msg := fmt.Sprintf("identity %s is denied by %s", tokIdentity, name)
+			log.Warn(msg)
+			return nil, outcomeDenied, status.Error(codes.PermissionDenied, msg)
+		}
+	}
+
+	// Add the SPIFFE ID of service accounts alongside their DNS-form identity,
+	// for interoperability with SPIFFE-based meshes. Issuers that only sign
+	// the CSR's DER encoding, like cert-manager, will only include it if the
+	// proxy requested it.
+	if len(csr.URIs) == 0 && svc.spiffeIDs != nil {
+		if spiffeID, err := svc.spiffeIDs.IdentitySpiffeID(tokIdentity); err == nil {
+			csr.URIs = []*url.URL{spiffeID}
+		}
 	}
 
 	// Create a certificate
-	issuer := *svc.issuer
 	crt, err := issuer.IssueEndEntityCrt(csr)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, outcomeError, status.Error(codes.Internal, err.Error())
+	}
+	if err := svc.checkDeniedChain(crt); err != nil {
+		log.Warnf("refusing to certify %s: %s", tokIdentity, err)
+		return nil, outcomeDenied, status.Error(codes.PermissionDenied, err.Error())
 	}
 	crts := crt.ExtractRaw()
 	if len(crts) == 0 {
@@ -225,7 +411,7 @@ func (svc *Service) Certify(ctx context.Context, req *pb.CertifyRequest) (*pb.Ce
 	validUntil, err := ptypes.TimestampProto(crt.Certificate.NotAfter)
 	if err != nil {
 		log.Errorf("invalid expiry time: %s", err)
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, outcomeError, status.Error(codes.Internal, err.Error())
 	}
 
 	rsp := &pb.CertifyResponse{
@@ -234,7 +420,21 @@ func (svc *Service) Certify(ctx context.Context, req *pb.CertifyRequest) (*pb.Ce
 
 		ValidUntil: validUntil,
 	}
-	return rsp, nil
+	return rsp, outcomeSuccess, nil
+}
+
+// checkDeniedChain returns an error if any certificate in crt's chain, e.g. a
+// compromised issuer certificate, has been denied.
+func (svc *Service) checkDeniedChain(crt tls.Crt) error {
+	if svc.denyList == nil {
+		return nil
+	}
+	for _, c := range append([]*x509.Certificate{crt.Certificate}, crt.TrustChain...) {
+		if name, ok := svc.denyList.DeniedSerialNumber(c.SerialNumber); ok {
+			return fmt.Errorf("certificate %s (serial number %x) is denied by %s", c.Subject.CommonName, c.SerialNumber, name)
+		}
+	}
+	return nil
 }
 
 func checkRequest(req *pb.CertifyRequest) (string, []byte, *x509.CertificateRequest, error) {
@@ -261,7 +461,7 @@ func checkRequest(req *pb.CertifyRequest) (string, []byte, *x509.CertificateRequ
 	return reqIdentity, tok, csr, nil
 }
 
-func checkCSR(csr *x509.CertificateRequest, identity string) error {
+func checkCSR(csr *x509.CertificateRequest, identity string, spiffeIDs SpiffeIDs) error {
 	if len(csr.DNSNames) != 1 {
 		return errors.New("CSR must have exactly one DNSName")
 	}
@@ -281,11 +481,7 @@ func checkCSR(csr *x509.CertificateRequest, identity string) error {
 	if len(csr.IPAddresses) > 0 {
 		return errors.New("cannot validate IP addresses")
 	}
-	if len(csr.URIs) > 0 {
-		return errors.New("cannot validate URIs")
-	}
-
-	return nil
+	return CheckSpiffeURIs(csr.URIs, identity, spiffeIDs)
 }
 
 func (NotAuthenticated) Error() string {
@@ -2,11 +2,24 @@ package identity
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	pb "github.com/linkerd/linkerd2-proxy-api/go/identity"
 	"github.com/linkerd/linkerd2/pkg/tls"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type fakeValidator struct {
@@ -27,6 +40,16 @@ func (fk *fakeValidator) Validate(context.Context, []byte) (string, error) {
 	return fk.result, fk.err
 }
 
+// fakeSpiffeIDs maps identities to their SPIFFE IDs.
+type fakeSpiffeIDs map[string]*url.URL
+
+func (f fakeSpiffeIDs) IdentitySpiffeID(identity string) (*url.URL, error) {
+	if spiffeID, ok := f[identity]; ok {
+		return spiffeID, nil
+	}
+	return nil, fmt.Errorf("not a service account identity: %s", identity)
+}
+
 func TestServiceNotReady(t *testing.T) {
 	//ch := make(chan tls.Issuer, 1)
 	svc := NewService(&fakeValidator{"successful-result", nil}, nil, nil, nil, "", "", "")
@@ -97,3 +120,335 @@ func TestInvalidRequestArguments(t *testing.T) {
 	}
 
 }
+
+type fakeExternalIssuer struct {
+	fakeIssuer
+}
+
+func (*fakeExternalIssuer) Name() string {
+	return "fake"
+}
+
+func TestExternalService(t *testing.T) {
+	issuer := &fakeExternalIssuer{}
+	svc := NewExternalService(&fakeValidator{"successful-result", nil}, nil, nil, nil, "", issuer)
+
+	if err := svc.Initialize(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if *svc.issuer != tls.Issuer(issuer) {
+		t.Fatalf("Expected the external issuer to be used, got %v", *svc.issuer)
+	}
+	if err := svc.ensureIssuerStillValid(svc.currentIssuer()); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func newCertifyRequest(t *testing.T, name string, uris ...*url.URL) *pb.CertifyRequest {
+	key, err := tls.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: name},
+		DNSNames: []string{name},
+		URIs:     uris,
+	}, key)
+	if err != nil {
+		t.Fatalf("Failed to create CSR: %s", err)
+	}
+	return &pb.CertifyRequest{Identity: name, Token: []byte("token"), CertificateSigningRequest: csr}
+}
+
+func TestCertifyConcurrently(t *testing.T) {
+	const (
+		identityName = "foo.ns.serviceaccount.identity.linkerd.cluster.local"
+		requests     = 20
+	)
+
+	root, err := tls.GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %s", err)
+	}
+	validity := tls.Validity{}
+	svc := NewService(&fakeValidator{identityName, nil}, root.Cred.CertPool(), &validity, nil, "identity.linkerd.cluster.local", "", "")
+	svc.updateIssuer(root)
+
+	succeeded := testutil.ToFloat64(certifyRequests.WithLabelValues(outcomeSuccess))
+	mismatched := testutil.ToFloat64(certifyRequests.WithLabelValues(outcomeIdentityMismatch))
+
+	serials := make(chan string, requests)
+	errs := make(chan error, requests)
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		req := newCertifyRequest(t, identityName)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rsp, err := svc.Certify(context.Background(), req)
+			if err != nil {
+				errs <- err
+				return
+			}
+			crt, err := x509.ParseCertificate(rsp.GetLeafCertificate())
+			if err != nil {
+				errs <- err
+				return
+			}
+			serials <- crt.SerialNumber.String()
+		}()
+	}
+	wg.Wait()
+	close(serials)
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	seen := map[string]bool{}
+	for serial := range serials {
+		if seen[serial] {
+			t.Fatalf("Serial number %s was issued twice", serial)
+		}
+		seen[serial] = true
+	}
+
+	if _, err := svc.Certify(context.Background(), newCertifyRequest(t, "bar.ns.serviceaccount.identity.linkerd.cluster.local")); err == nil {
+		t.Fatal("Expected an error for a mismatched identity")
+	}
+
+	if got := testutil.ToFloat64(certifyRequests.WithLabelValues(outcomeSuccess)) - succeeded; got != requests {
+		t.Fatalf("Expected %d successful issuances to be recorded, got %v", requests, got)
+	}
+	if got := testutil.ToFloat64(certifyRequests.WithLabelValues(outcomeIdentityMismatch)) - mismatched; got != 1 {
+		t.Fatalf("Expected 1 identity mismatch to be recorded, got %v", got)
+	}
+}
+
+type fakeDenyList struct {
+	identities map[string]bool
+	serials    map[string]bool
+}
+
+func (d *fakeDenyList) DeniedIdentity(identity string) (string, bool) {
+	return "compromised", d.identities[identity]
+}
+
+func (d *fakeDenyList) DeniedSerialNumber(serial *big.Int) (string, bool) {
+	return "compromised", d.serials[serial.String()]
+}
+
+func TestCertifyDenied(t *testing.T) {
+	const identityName = "foo.ns.serviceaccount.identity.linkerd.cluster.local"
+
+	root, err := tls.GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %s", err)
+	}
+	validity := tls.Validity{}
+	svc := NewService(&fakeValidator{identityName, nil}, root.Cred.CertPool(), &validity, nil, "identity.linkerd.cluster.local", "", "")
+	svc.updateIssuer(root)
+
+	denyList := &fakeDenyList{identities: map[string]bool{}, serials: map[string]bool{}}
+	svc.SetDenyList(denyList)
+
+	if _, err := svc.Certify(context.Background(), newCertifyRequest(t, identityName)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	denied := testutil.ToFloat64(certifyRequests.WithLabelValues(outcomeDenied))
+
+	denyList.identities[identityName] = true
+	_, err = svc.Certify(context.Background(), newCertifyRequest(t, identityName))
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("Expected a PermissionDenied error for a denied identity, got %v", err)
+	}
+
+	delete(denyList.identities, identityName)
+	denyList.serials[root.Cred.Crt.Certificate.SerialNumber.String()] = true
+	_, err = svc.Certify(context.Background(), newCertifyRequest(t, identityName))
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("Expected a PermissionDenied error for a denied issuer, got %v", err)
+	}
+
+	if got := testutil.ToFloat64(certifyRequests.WithLabelValues(outcomeDenied)) - denied; got != 2 {
+		t.Fatalf("Expected 2 denials to be recorded, got %v", got)
+	}
+}
+
+func TestCertifySpiffeID(t *testing.T) {
+	const identityName = "foo.ns.serviceaccount.identity.linkerd.cluster.local"
+	spiffeID := NewSpiffeID("cluster.local", "ns", "foo")
+
+	root, err := tls.GenerateRootCAWithDefaults("identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %s", err)
+	}
+	validity := tls.Validity{}
+	svc := NewService(&fakeValidator{identityName, nil}, root.Cred.CertPool(), &validity, nil, "identity.linkerd.cluster.local", "", "")
+	svc.updateIssuer(root)
+	svc.SetSpiffeIDs(fakeSpiffeIDs{identityName: spiffeID})
+
+	for _, tc := range []struct {
+		name string
+		uris []*url.URL
+	}{
+		{"without URIs in the CSR", nil},
+		{"with the SPIFFE ID in the CSR", []*url.URL{spiffeID}},
+	} {
+		tc := tc // pin
+		t.Run("Issues the SPIFFE ID alongside the DNS name "+tc.name, func(t *testing.T) {
+			rsp, err := svc.Certify(context.Background(), newCertifyRequest(t, identityName, tc.uris...))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			crt, err := x509.ParseCertificate(rsp.GetLeafCertificate())
+			if err != nil {
+				t.Fatalf("Failed to parse certificate: %s", err)
+			}
+			if len(crt.DNSNames) != 1 || crt.DNSNames[0] != identityName {
+				t.Fatalf("Expected DNS names [%s], got %v", identityName, crt.DNSNames)
+			}
+			if len(crt.URIs) != 1 || crt.URIs[0].String() != "spiffe://cluster.local/ns/ns/sa/foo" {
+				t.Fatalf("Expected URIs [spiffe://cluster.local/ns/ns/sa/foo], got %v", crt.URIs)
+			}
+		})
+	}
+
+	t.Run("Rejects CSRs requesting another URI", func(t *testing.T) {
+		other := NewSpiffeID("cluster.local", "ns", "bar")
+		_, err := svc.Certify(context.Background(), newCertifyRequest(t, identityName, other))
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("Expected a FailedPrecondition error, got %v", err)
+		}
+	})
+
+	t.Run("Issues no SPIFFE ID without a trust domain", func(t *testing.T) {
+		svc := NewService(&fakeValidator{identityName, nil}, root.Cred.CertPool(), &validity, nil, "identity.linkerd.cluster.local", "", "")
+		svc.updateIssuer(root)
+
+		rsp, err := svc.Certify(context.Background(), newCertifyRequest(t, identityName))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		crt, err := x509.ParseCertificate(rsp.GetLeafCertificate())
+		if err != nil {
+			t.Fatalf("Failed to parse certificate: %s", err)
+		}
+		if len(crt.URIs) != 0 {
+			t.Fatalf("Expected no URIs, got %v", crt.URIs)
+		}
+
+		_, err = svc.Certify(context.Background(), newCertifyRequest(t, identityName, spiffeID))
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("Expected a FailedPrecondition error, got %v", err)
+		}
+	})
+}
+
+func TestReady(t *testing.T) {
+	const name = "identity.linkerd.cluster.local"
+
+	root, err := tls.GenerateRootCAWithDefaults(name)
+	if err != nil {
+		t.Fatalf("Failed to create CA: %s", err)
+	}
+	key, err := tls.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+	past := time.Now().Add(-48 * time.Hour)
+	expired, err := tls.CreateRootCA(name, key, tls.Validity{ValidFrom: &past, Lifetime: 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("Failed to create CA: %s", err)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		issuer *tls.CA
+		ready  bool
+	}{
+		{"without an issuer", nil, false},
+		{"with a valid issuer", root, true},
+		{"with an expired issuer", expired, false},
+	} {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			anchors := root.Cred.CertPool()
+			anchors.AddCert(expired.Cred.Certificate)
+			svc := NewService(&fakeValidator{}, anchors, &tls.Validity{}, nil, name, "", "")
+			if tc.issuer != nil {
+				svc.updateIssuer(tc.issuer)
+			}
+
+			err := svc.Ready()
+			if tc.ready && err != nil {
+				t.Fatalf("Expected the service to be ready, got %s", err)
+			}
+			if !tc.ready && err == nil {
+				t.Fatal("Expected the service not to be ready")
+			}
+		})
+	}
+}
+
+func TestReloadIssuer(t *testing.T) {
+	const name = "identity.linkerd.cluster.local"
+
+	dir, err := ioutil.TempDir("", "issuer")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	crtPath := filepath.Join(dir, "crt.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+	writeIssuer := func(ca *tls.CA) {
+		if err := ioutil.WriteFile(crtPath, []byte(ca.Cred.EncodeCertificatePEM()), 0600); err != nil {
+			t.Fatalf("Failed to write certificate: %s", err)
+		}
+		if err := ioutil.WriteFile(keyPath, []byte(ca.Cred.EncodePrivateKeyPEM()), 0600); err != nil {
+			t.Fatalf("Failed to write key: %s", err)
+		}
+	}
+
+	first, err := tls.GenerateRootCAWithDefaults(name)
+	if err != nil {
+		t.Fatalf("Failed to create CA: %s", err)
+	}
+	second, err := tls.GenerateRootCAWithDefaults(name)
+	if err != nil {
+		t.Fatalf("Failed to create CA: %s", err)
+	}
+	anchors := first.Cred.CertPool()
+	anchors.AddCert(second.Cred.Certificate)
+
+	events := []string{}
+	recordEvent := func(eventType, reason, message string) { events = append(events, reason) }
+	svc := NewService(&fakeValidator{}, anchors, &tls.Validity{}, recordEvent, name, crtPath, keyPath)
+
+	writeIssuer(first)
+	if err := svc.Initialize(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// Reloading unchanged credentials doesn't update the issuer.
+	svc.reloadIssuer()
+	if len(events) != 0 {
+		t.Fatalf("Expected no events, got %v", events)
+	}
+
+	writeIssuer(second)
+	svc.reloadIssuer()
+	if len(events) != 1 || events[0] != eventTypeUpdated {
+		t.Fatalf("Expected [%s] events, got %v", eventTypeUpdated, events)
+	}
+	current := svc.currentIssuer().(*tls.CA)
+	if !current.Cred.Certificate.Equal(second.Cred.Certificate) {
+		t.Fatal("Expected the issuer to be reloaded")
+	}
+
+	expiry := testutil.ToFloat64(issuerExpiry)
+	if expiry != float64(second.Cred.Certificate.NotAfter.Unix()) {
+		t.Fatalf("Expected issuer expiry %d, got %f", second.Cred.Certificate.NotAfter.Unix(), expiry)
+	}
+}
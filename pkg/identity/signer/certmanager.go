@@ -0,0 +1,203 @@
+package signer
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/tls"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	certManagerGroup = "cert-manager.io"
+
+	certRequestPollInterval = 500 * time.Millisecond
+)
+
+// CertificateRequestResource is the cert-manager CertificateRequest resource.
+var CertificateRequestResource = schema.GroupVersionResource{
+	Group:    certManagerGroup,
+	Version:  "v1",
+	Resource: "certificaterequests",
+}
+
+type (
+	// CertManagerConfig configures a CertManagerIssuer.
+	CertManagerConfig struct {
+		// Namespace in which CertificateRequests are created.
+		Namespace string
+
+		// IssuerName is the name of the cert-manager Issuer or ClusterIssuer
+		// signing the requests.
+		IssuerName string
+
+		// IssuerKind is either Issuer or ClusterIssuer.
+		IssuerKind string
+
+		// IssuerGroup is the API group of the issuer, cert-manager.io by
+		// default. External cert-manager issuers live in their own groups.
+		IssuerGroup string
+
+		// Timeout bounds the time spent waiting for a request to be signed.
+		Timeout time.Duration
+	}
+
+	// CertManagerIssuer forwards CSRs to cert-manager by creating a
+	// CertificateRequest and waiting for it to be signed.
+	CertManagerIssuer struct {
+		client       dynamic.Interface
+		config       CertManagerConfig
+		trustAnchors *x509.CertPool
+		validity     tls.Validity
+	}
+)
+
+// NewCertManagerIssuer returns an issuer that has CSRs signed through
+// cert-manager CertificateRequests. Issued certificates must verify with the
+// given trust anchors.
+func NewCertManagerIssuer(client dynamic.Interface, config CertManagerConfig, trustAnchors *x509.CertPool, validity tls.Validity) (*CertManagerIssuer, error) {
+	if config.Namespace == "" {
+		return nil, fmt.Errorf("missing CertificateRequest namespace")
+	}
+	if config.IssuerName == "" {
+		return nil, fmt.Errorf("missing cert-manager issuer name")
+	}
+	switch config.IssuerKind {
+	case "":
+		config.IssuerKind = "Issuer"
+	case "Issuer", "ClusterIssuer":
+	default:
+		if config.IssuerGroup == "" || config.IssuerGroup == certManagerGroup {
+			return nil, fmt.Errorf("invalid cert-manager issuer kind: %s", config.IssuerKind)
+		}
+	}
+	if config.IssuerGroup == "" {
+		config.IssuerGroup = certManagerGroup
+	}
+	if config.Timeout == 0 {
+		config.Timeout = defaultRequestTimeout
+	}
+	return &CertManagerIssuer{client, config, trustAnchors, validity}, nil
+}
+
+// Name describes the external signer.
+func (c *CertManagerIssuer) Name() string {
+	return fmt.Sprintf("cert-manager %s.%s/%s", c.config.IssuerKind, c.config.IssuerGroup, c.config.IssuerName)
+}
+
+// IssueEndEntityCrt creates a CertificateRequest for the CSR and waits for
+// cert-manager to sign it. The CertificateRequest is deleted once done.
+func (c *CertManagerIssuer) IssueEndEntityCrt(csr *x509.CertificateRequest) (tls.Crt, error) {
+	name, err := csrName(csr)
+	if err != nil {
+		return tls.Crt{}, err
+	}
+	csrPEM, err := encodeCSR(csr)
+	if err != nil {
+		return tls.Crt{}, err
+	}
+
+	cr := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": CertificateRequestResource.GroupVersion().String(),
+			"kind":       "CertificateRequest",
+			"metadata": map[string]interface{}{
+				"generateName": "linkerd-identity-",
+				"namespace":    c.config.Namespace,
+				"annotations": map[string]interface{}{
+					"linkerd.io/identity": name,
+				},
+			},
+			"spec": map[string]interface{}{
+				"request":  base64.StdEncoding.EncodeToString([]byte(csrPEM)),
+				"duration": lifetime(c.validity).String(),
+				"isCA":     false,
+				"usages": []interface{}{
+					"digital signature", "key encipherment", "server auth", "client auth",
+				},
+				"issuerRef": map[string]interface{}{
+					"name":  c.config.IssuerName,
+					"kind":  c.config.IssuerKind,
+					"group": c.config.IssuerGroup,
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.Timeout)
+	defer cancel()
+
+	requests := c.client.Resource(CertificateRequestResource).Namespace(c.config.Namespace)
+	created, err := requests.Create(ctx, cr, metav1.CreateOptions{})
+	if err != nil {
+		return tls.Crt{}, fmt.Errorf("failed to create CertificateRequest: %s", err)
+	}
+	crName := created.GetName()
+	log.Debugf("created CertificateRequest %s/%s for %s", c.config.Namespace, crName, name)
+	defer func() {
+		if err := requests.Delete(context.Background(), crName, metav1.DeleteOptions{}); err != nil {
+			log.Warnf("failed to delete CertificateRequest %s/%s: %s", c.config.Namespace, crName, err)
+		}
+	}()
+
+	var chain []string
+	err = wait.PollImmediateUntil(certRequestPollInterval, func() (bool, error) {
+		current, err := requests.Get(ctx, crName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		chain, err = signedChain(current)
+		return chain != nil, err
+	}, ctx.Done())
+	if err != nil {
+		return tls.Crt{}, fmt.Errorf("CertificateRequest %s/%s was not signed: %s", c.config.Namespace, crName, err)
+	}
+
+	return decodeSignedCrt(chain, c.trustAnchors, name)
+}
+
+// signedChain returns the PEM-encoded certificate and CA of a signed
+// CertificateRequest, nil if it is still pending, or an error if it has been
+// denied or has failed.
+func signedChain(cr *unstructured.Unstructured) ([]string, error) {
+	conditions, _, _ := unstructured.NestedSlice(cr.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch {
+		case cond["type"] == "Denied" && cond["status"] == "True",
+			cond["type"] == "InvalidRequest" && cond["status"] == "True",
+			cond["type"] == "Ready" && cond["status"] == "False" && cond["reason"] == "Failed":
+			return nil, fmt.Errorf("%s: %v", cond["reason"], cond["message"])
+		}
+	}
+
+	crt, _, _ := unstructured.NestedString(cr.Object, "status", "certificate")
+	if crt == "" {
+		return nil, nil
+	}
+	crtPEM, err := base64.StdEncoding.DecodeString(crt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate: %s", err)
+	}
+	chain := []string{string(crtPEM)}
+
+	if ca, _, _ := unstructured.NestedString(cr.Object, "status", "ca"); ca != "" {
+		caPEM, err := base64.StdEncoding.DecodeString(ca)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CA: %s", err)
+		}
+		chain = append(chain, string(caPEM))
+	}
+	return chain, nil
+}
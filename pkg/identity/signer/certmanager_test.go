@@ -0,0 +1,121 @@
+package signer
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/tls"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newFakeCertManager returns a dynamic client whose CertificateRequests are
+// handled by a stand-in for cert-manager: requests are signed by ca, unless
+// deny is set.
+func newFakeCertManager(t *testing.T, ca *tls.CA, deny bool) *dynamicfake.FakeDynamicClient {
+	scheme := runtime.NewScheme()
+	client := dynamicfake.NewSimpleDynamicClient(scheme)
+
+	n := 0
+	client.PrependReactor("create", "certificaterequests", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		cr := action.(k8stesting.CreateAction).GetObject().(*unstructured.Unstructured)
+		n++
+		cr.SetName(fmt.Sprintf("%s%d", cr.GetGenerateName(), n))
+
+		if deny {
+			unstructured.SetNestedSlice(cr.Object, []interface{}{
+				map[string]interface{}{
+					"type":    "Denied",
+					"status":  "True",
+					"reason":  "PolicyDenied",
+					"message": "identity not allowed",
+				},
+			}, "status", "conditions")
+			return false, nil, nil
+		}
+
+		request, _, _ := unstructured.NestedString(cr.Object, "spec", "request")
+		csrPEM, err := base64.StdEncoding.DecodeString(request)
+		if err != nil {
+			t.Fatalf("Stand-in cert-manager received an invalid request: %s", err)
+		}
+		crt, chain := signPEM(t, ca, string(csrPEM))
+		unstructured.SetNestedField(cr.Object, base64.StdEncoding.EncodeToString([]byte(crt+chain)), "status", "certificate")
+		return false, nil, nil
+	})
+
+	return client
+}
+
+func TestCertManagerIssuer(t *testing.T) {
+	root, intermediate := newTestPKI(t)
+	anchors := root.Cred.Crt.CertPool()
+	config := CertManagerConfig{
+		Namespace:  "linkerd",
+		IssuerName: "linkerd-identity",
+		IssuerKind: "ClusterIssuer",
+		Timeout:    5 * time.Second,
+	}
+
+	t.Run("signs CSRs", func(t *testing.T) {
+		client := newFakeCertManager(t, intermediate, false)
+		issuer, err := NewCertManagerIssuer(client, config, anchors, tls.Validity{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		crt, err := issuer.IssueEndEntityCrt(newTestCSR(t, identityName))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if crt.Certificate.DNSNames[0] != identityName {
+			t.Errorf("Expected certificate for %s, got %v", identityName, crt.Certificate.DNSNames)
+		}
+
+		var created *unstructured.Unstructured
+		for _, action := range client.Actions() {
+			if create, ok := action.(k8stesting.CreateAction); ok {
+				created = create.GetObject().(*unstructured.Unstructured)
+			}
+		}
+		if created == nil {
+			t.Fatal("Expected a CertificateRequest to be created")
+		}
+		if kind, _, _ := unstructured.NestedString(created.Object, "spec", "issuerRef", "kind"); kind != "ClusterIssuer" {
+			t.Errorf("Expected issuerRef kind ClusterIssuer, got %s", kind)
+		}
+
+		_, err = client.Resource(CertificateRequestResource).Namespace("linkerd").Get(context.Background(), created.GetName(), metav1.GetOptions{})
+		if !kerrors.IsNotFound(err) {
+			t.Errorf("Expected the CertificateRequest to be deleted, got %v", err)
+		}
+	})
+
+	t.Run("surfaces denied requests", func(t *testing.T) {
+		client := newFakeCertManager(t, intermediate, true)
+		issuer, err := NewCertManagerIssuer(client, config, anchors, tls.Validity{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		_, err = issuer.IssueEndEntityCrt(newTestCSR(t, identityName))
+		expected := "CertificateRequest linkerd/linkerd-identity-1 was not signed: PolicyDenied: identity not allowed"
+		if err == nil || err.Error() != expected {
+			t.Errorf("Expected error %q, got %v", expected, err)
+		}
+	})
+
+	t.Run("validates its configuration", func(t *testing.T) {
+		_, err := NewCertManagerIssuer(nil, CertManagerConfig{Namespace: "linkerd", IssuerName: "x", IssuerKind: "Foo"}, anchors, tls.Validity{})
+		if err == nil {
+			t.Error("Expected an invalid issuer kind to be rejected")
+		}
+	})
+}
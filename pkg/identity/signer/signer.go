@@ -0,0 +1,78 @@
+// Package signer provides identity issuers that forward certificate signing
+// requests to a PKI outside of the identity controller, so that the issuer's
+// private key never has to be handed to Linkerd.
+package signer
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/identity"
+	"github.com/linkerd/linkerd2/pkg/tls"
+)
+
+// encodeCSR returns the PEM encoding of a certificate signing request.
+func encodeCSR(csr *x509.CertificateRequest) (string, error) {
+	if len(csr.Raw) == 0 {
+		return "", errors.New("CSR has no DER encoding")
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr.Raw})), nil
+}
+
+// csrName returns the identity requested by a CSR.
+func csrName(csr *x509.CertificateRequest) (string, error) {
+	if len(csr.DNSNames) != 1 {
+		return "", errors.New("CSR must have exactly one DNSName")
+	}
+	return csr.DNSNames[0], nil
+}
+
+// csrURIs returns the comma-separated URI SANs of a CSR, which may have been
+// added by the identity service after the CSR was encoded. They're checked
+// the same way the identity service checks the CSRs it receives, so that no
+// other URI than the SPIFFE ID of name is forwarded to the signer.
+func csrURIs(csr *x509.CertificateRequest, name string, spiffeIDs identity.SpiffeIDs) (string, error) {
+	if err := identity.CheckSpiffeURIs(csr.URIs, name, spiffeIDs); err != nil {
+		return "", err
+	}
+	uris := make([]string, len(csr.URIs))
+	for i, u := range csr.URIs {
+		uris[i] = u.String()
+	}
+	return strings.Join(uris, ","), nil
+}
+
+// decodeSignedCrt decodes the PEM-encoded certificate chain returned by an
+// external signer and verifies it against the trust anchors, so that a
+// misconfigured signer can't hand proxies certificates that won't validate
+// in the mesh.
+func decodeSignedCrt(chainPEM []string, trustAnchors *x509.CertPool, name string) (tls.Crt, error) {
+	crt, err := tls.DecodePEMCrt(strings.Join(chainPEM, "\n"))
+	if err != nil {
+		return tls.Crt{}, fmt.Errorf("failed to decode certificate returned by signer: %s", err)
+	}
+
+	if err := crt.Verify(trustAnchors, name, time.Time{}); err != nil {
+		return tls.Crt{}, fmt.Errorf("certificate returned by signer does not verify with trust anchors: %s", err)
+	}
+
+	// Trust anchors are distributed separately, no need to send them over
+	// the wire with every certificate.
+	chain := []*x509.Certificate{}
+	for _, c := range crt.TrustChain {
+		if !isSelfSigned(c) {
+			chain = append(chain, c)
+		}
+	}
+	crt.TrustChain = chain
+
+	return *crt, nil
+}
+
+func isSelfSigned(c *x509.Certificate) bool {
+	return c.CheckSignatureFrom(c) == nil
+}
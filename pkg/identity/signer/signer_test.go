@@ -0,0 +1,104 @@
+package signer
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/linkerd/linkerd2/pkg/tls"
+)
+
+const identityName = "foo.ns.serviceaccount.identity.linkerd.cluster.local"
+
+// testSpiffeIDs maps identityName to its SPIFFE ID.
+type testSpiffeIDs struct{}
+
+func (testSpiffeIDs) IdentitySpiffeID(identity string) (*url.URL, error) {
+	if identity != identityName {
+		return nil, fmt.Errorf("not a service account identity: %s", identity)
+	}
+	return &url.URL{Scheme: "spiffe", Host: "cluster.local", Path: "/ns/ns/sa/foo"}, nil
+}
+
+// newTestPKI returns a root CA and an intermediate CA it signed, standing in
+// for the PKI behind an external signer.
+func newTestPKI(t *testing.T) (*tls.CA, *tls.CA) {
+	root, err := tls.GenerateRootCAWithDefaults("root.example.com")
+	if err != nil {
+		t.Fatalf("Failed to create root CA: %s", err)
+	}
+	intermediate, err := root.GenerateCA("identity.linkerd.cluster.local", -1)
+	if err != nil {
+		t.Fatalf("Failed to create intermediate CA: %s", err)
+	}
+	return root, intermediate
+}
+
+func newTestCSR(t *testing.T, name string) *x509.CertificateRequest {
+	key, err := tls.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: name},
+		DNSNames: []string{name},
+	}, key)
+	if err != nil {
+		t.Fatalf("Failed to create CSR: %s", err)
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		t.Fatalf("Failed to parse CSR: %s", err)
+	}
+	return csr
+}
+
+// signPEM signs a PEM-encoded CSR with the given CA, returning the
+// PEM-encoded leaf and issuer chain.
+func signPEM(t *testing.T, ca *tls.CA, csrPEM string) (string, string) {
+	block, _ := pem.Decode([]byte(csrPEM))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		t.Fatalf("Stand-in signer received an invalid CSR: %s", csrPEM)
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		t.Fatalf("Stand-in signer failed to parse CSR: %s", err)
+	}
+	crt, err := ca.IssueEndEntityCrt(csr)
+	if err != nil {
+		t.Fatalf("Stand-in signer failed to sign CSR: %s", err)
+	}
+	return crt.EncodeCertificatePEM(), ca.Cred.Crt.EncodePEM()
+}
+
+func TestDecodeSignedCrt(t *testing.T) {
+	root, intermediate := newTestPKI(t)
+	leaf, err := intermediate.GenerateEndEntityCred(identityName)
+	if err != nil {
+		t.Fatalf("Failed to issue leaf: %s", err)
+	}
+
+	anchors := root.Cred.Crt.CertPool()
+	chain := []string{leaf.EncodeCertificatePEM(), intermediate.Cred.Crt.EncodePEM()}
+
+	crt, err := decodeSignedCrt(chain, anchors, identityName)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(crt.TrustChain) != 1 || !crt.TrustChain[0].Equal(intermediate.Cred.Crt.Certificate) {
+		t.Errorf("Expected the trust chain to only hold the intermediate, got %d certificates", len(crt.TrustChain))
+	}
+
+	if _, err := decodeSignedCrt(chain, anchors, "bar.ns.serviceaccount.identity.linkerd.cluster.local"); err == nil {
+		t.Error("Expected a certificate for another name to be rejected")
+	}
+
+	otherRoot, _ := newTestPKI(t)
+	if _, err := decodeSignedCrt(chain, otherRoot.Cred.Crt.CertPool(), identityName); err == nil {
+		t.Error("Expected a certificate that doesn't verify with the trust anchors to be rejected")
+	}
+}
@@ -0,0 +1,167 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/identity"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	log "github.com/sirupsen/logrus"
+)
+
+const defaultRequestTimeout = 30 * time.Second
+
+type (
+	// VaultConfig configures a VaultIssuer.
+	VaultConfig struct {
+		// Addr is the base URL of the Vault server, e.g. https://vault:8200
+		Addr string
+
+		// SignPath is the path of the PKI sign endpoint, relative to /v1/, e.g.
+		// pki_int/sign/linkerd-identity
+		SignPath string
+
+		// TokenPath is the file holding the Vault token. It is read on every
+		// request so that it can be rotated by an agent sidecar.
+		TokenPath string
+
+		// Namespace is the optional Vault Enterprise namespace.
+		Namespace string
+
+		// Client is used to talk to Vault. If nil, http.DefaultClient is used.
+		Client *http.Client
+
+		// SpiffeIDs looks up the SPIFFE ID of the requested identities, the
+		// only URI SAN forwarded to Vault. If nil, CSRs with URI SANs are
+		// rejected.
+		SpiffeIDs identity.SpiffeIDs
+	}
+
+	// VaultIssuer forwards CSRs to a Vault-compatible PKI secrets engine.
+	VaultIssuer struct {
+		config       VaultConfig
+		trustAnchors *x509.CertPool
+		validity     tls.Validity
+	}
+
+	vaultSignRequest struct {
+		CSR        string `json:"csr"`
+		CommonName string `json:"common_name"`
+		URISANs    string `json:"uri_sans,omitempty"`
+		TTL        string `json:"ttl,omitempty"`
+		Format     string `json:"format"`
+	}
+
+	vaultSignResponse struct {
+		Data struct {
+			Certificate string   `json:"certificate"`
+			IssuingCA   string   `json:"issuing_ca"`
+			CAChain     []string `json:"ca_chain"`
+		} `json:"data"`
+		Errors []string `json:"errors"`
+	}
+)
+
+// NewVaultIssuer returns an issuer that has CSRs signed by Vault. Issued
+// certificates must verify with the given trust anchors.
+func NewVaultIssuer(config VaultConfig, trustAnchors *x509.CertPool, validity tls.Validity) (*VaultIssuer, error) {
+	if config.Addr == "" {
+		return nil, fmt.Errorf("missing Vault address")
+	}
+	if config.SignPath == "" {
+		return nil, fmt.Errorf("missing Vault sign path")
+	}
+	if config.Client == nil {
+		config.Client = &http.Client{Timeout: defaultRequestTimeout}
+	}
+	return &VaultIssuer{config, trustAnchors, validity}, nil
+}
+
+// Name describes the external signer.
+func (v *VaultIssuer) Name() string {
+	return fmt.Sprintf("vault %s/v1/%s", strings.TrimSuffix(v.config.Addr, "/"), v.config.SignPath)
+}
+
+// IssueEndEntityCrt has the CSR signed by Vault.
+func (v *VaultIssuer) IssueEndEntityCrt(csr *x509.CertificateRequest) (tls.Crt, error) {
+	name, err := csrName(csr)
+	if err != nil {
+		return tls.Crt{}, err
+	}
+	csrPEM, err := encodeCSR(csr)
+	if err != nil {
+		return tls.Crt{}, err
+	}
+	uriSANs, err := csrURIs(csr, name, v.config.SpiffeIDs)
+	if err != nil {
+		return tls.Crt{}, err
+	}
+
+	body, err := json.Marshal(vaultSignRequest{
+		CSR:        csrPEM,
+		CommonName: name,
+		URISANs:    uriSANs,
+		TTL:        lifetime(v.validity).String(),
+		Format:     "pem",
+	})
+	if err != nil {
+		return tls.Crt{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	url := fmt.Sprintf("%s/v1/%s", strings.TrimSuffix(v.config.Addr, "/"), strings.TrimPrefix(v.config.SignPath, "/"))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return tls.Crt{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if v.config.TokenPath != "" {
+		token, err := ioutil.ReadFile(v.config.TokenPath)
+		if err != nil {
+			return tls.Crt{}, fmt.Errorf("failed to read Vault token: %s", err)
+		}
+		req.Header.Set("X-Vault-Token", strings.TrimSpace(string(token)))
+	}
+	if v.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.config.Namespace)
+	}
+
+	log.Debugf("forwarding CSR for %s to %s", name, v.Name())
+	rsp, err := v.config.Client.Do(req)
+	if err != nil {
+		return tls.Crt{}, fmt.Errorf("failed to reach Vault: %s", err)
+	}
+	defer rsp.Body.Close()
+
+	var signed vaultSignResponse
+	if err := json.NewDecoder(rsp.Body).Decode(&signed); err != nil {
+		return tls.Crt{}, fmt.Errorf("failed to decode Vault response (HTTP %d): %s", rsp.StatusCode, err)
+	}
+	if rsp.StatusCode != http.StatusOK {
+		return tls.Crt{}, fmt.Errorf("Vault refused to sign CSR (HTTP %d): %s", rsp.StatusCode, strings.Join(signed.Errors, "; "))
+	}
+
+	chain := []string{signed.Data.Certificate}
+	if len(signed.Data.CAChain) > 0 {
+		chain = append(chain, signed.Data.CAChain...)
+	} else if signed.Data.IssuingCA != "" {
+		chain = append(chain, signed.Data.IssuingCA)
+	}
+	return decodeSignedCrt(chain, v.trustAnchors, name)
+}
+
+func lifetime(v tls.Validity) time.Duration {
+	if v.Lifetime == 0 {
+		return tls.DefaultLifetime
+	}
+	return v.Lifetime
+}
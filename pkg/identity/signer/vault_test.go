@@ -0,0 +1,153 @@
+package signer
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/tls"
+)
+
+func TestVaultIssuer(t *testing.T) {
+	root, intermediate := newTestPKI(t)
+
+	dir, err := ioutil.TempDir("", "vault-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	tokenPath := filepath.Join(dir, "token")
+	if err := ioutil.WriteFile(tokenPath, []byte("s.secret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Stand-in for Vault's PKI secrets engine
+	var received vaultSignRequest
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/pki_int/sign/linkerd" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":["no handler for route"]}`))
+			return
+		}
+		if r.Header.Get("X-Vault-Token") != "s.secret" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Fatalf("Stand-in Vault failed to decode request: %s", err)
+		}
+
+		crt, chain := signPEM(t, intermediate, received.CSR)
+		rsp := vaultSignResponse{}
+		rsp.Data.Certificate = crt
+		rsp.Data.IssuingCA = chain
+		json.NewEncoder(w).Encode(rsp)
+	}))
+	defer vault.Close()
+
+	validity := tls.Validity{Lifetime: time.Hour}
+	anchors := root.Cred.Crt.CertPool()
+
+	t.Run("signs CSRs", func(t *testing.T) {
+		issuer, err := NewVaultIssuer(VaultConfig{
+			Addr:      vault.URL,
+			SignPath:  "pki_int/sign/linkerd",
+			TokenPath: tokenPath,
+		}, anchors, validity)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		crt, err := issuer.IssueEndEntityCrt(newTestCSR(t, identityName))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if crt.Certificate.DNSNames[0] != identityName {
+			t.Errorf("Expected certificate for %s, got %v", identityName, crt.Certificate.DNSNames)
+		}
+		if received.CommonName != identityName {
+			t.Errorf("Expected common_name %s, got %s", identityName, received.CommonName)
+		}
+		if received.TTL != "1h0m0s" {
+			t.Errorf("Expected ttl 1h0m0s, got %s", received.TTL)
+		}
+	})
+
+	t.Run("forwards the URI SANs added to CSRs", func(t *testing.T) {
+		issuer, err := NewVaultIssuer(VaultConfig{
+			Addr:      vault.URL,
+			SignPath:  "pki_int/sign/linkerd",
+			TokenPath: tokenPath,
+			SpiffeIDs: testSpiffeIDs{},
+		}, anchors, validity)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		csr := newTestCSR(t, identityName)
+		csr.URIs = []*url.URL{{Scheme: "spiffe", Host: "cluster.local", Path: "/ns/ns/sa/foo"}}
+		if _, err := issuer.IssueEndEntityCrt(csr); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if received.URISANs != "spiffe://cluster.local/ns/ns/sa/foo" {
+			t.Errorf("Expected uri_sans spiffe://cluster.local/ns/ns/sa/foo, got %s", received.URISANs)
+		}
+	})
+
+	t.Run("rejects URI SANs other than the SPIFFE ID of the identity", func(t *testing.T) {
+		issuer, err := NewVaultIssuer(VaultConfig{
+			Addr:      vault.URL,
+			SignPath:  "pki_int/sign/linkerd",
+			TokenPath: tokenPath,
+			SpiffeIDs: testSpiffeIDs{},
+		}, anchors, validity)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		csr := newTestCSR(t, identityName)
+		csr.URIs = []*url.URL{{Scheme: "spiffe", Host: "cluster.local", Path: "/ns/ns/sa/admin"}}
+		if _, err := issuer.IssueEndEntityCrt(csr); err == nil {
+			t.Error("Expected an error, got nothing")
+		}
+	})
+
+	t.Run("surfaces Vault errors", func(t *testing.T) {
+		issuer, err := NewVaultIssuer(VaultConfig{
+			Addr:     vault.URL,
+			SignPath: "pki_int/sign/linkerd",
+		}, anchors, validity)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		_, err = issuer.IssueEndEntityCrt(newTestCSR(t, identityName))
+		expected := "Vault refused to sign CSR (HTTP 403): permission denied"
+		if err == nil || err.Error() != expected {
+			t.Errorf("Expected error %q, got %v", expected, err)
+		}
+	})
+
+	t.Run("rejects certificates from another PKI", func(t *testing.T) {
+		otherRoot, _ := newTestPKI(t)
+		issuer, err := NewVaultIssuer(VaultConfig{
+			Addr:      vault.URL,
+			SignPath:  "pki_int/sign/linkerd",
+			TokenPath: tokenPath,
+		}, otherRoot.Cred.Crt.CertPool(), validity)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if _, err := issuer.IssueEndEntityCrt(newTestCSR(t, identityName)); err == nil {
+			t.Error("Expected an error, got nothing")
+		}
+	})
+}
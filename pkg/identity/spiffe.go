@@ -0,0 +1,40 @@
+package identity
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// SpiffeScheme is the URI scheme of SPIFFE IDs.
+const SpiffeScheme = "spiffe"
+
+// NewSpiffeID returns the SPIFFE ID of a service account in the given trust
+// domain, e.g. spiffe://cluster.local/ns/emojivoto/sa/web
+func NewSpiffeID(trustDomain, ns, sa string) *url.URL {
+	return &url.URL{
+		Scheme: SpiffeScheme,
+		Host:   trustDomain,
+		Path:   fmt.Sprintf("/ns/%s/sa/%s", ns, sa),
+	}
+}
+
+// CheckSpiffeURIs checks that the only URI SAN requested for an identity is
+// its SPIFFE ID, as looked up in spiffeIDs. Identities that don't name a
+// service account can't have any, nor can any identity if spiffeIDs is nil.
+func CheckSpiffeURIs(uris []*url.URL, identity string, spiffeIDs SpiffeIDs) error {
+	if len(uris) == 0 {
+		return nil
+	}
+	if spiffeIDs == nil {
+		return errors.New("cannot validate URIs")
+	}
+	spiffeID, err := spiffeIDs.IdentitySpiffeID(identity)
+	if err != nil {
+		return fmt.Errorf("cannot validate URIs: %s", err)
+	}
+	if len(uris) != 1 || uris[0].String() != spiffeID.String() {
+		return fmt.Errorf("URIs do not match requested identity: uris=%v; req=%s", uris, spiffeID)
+	}
+	return nil
+}
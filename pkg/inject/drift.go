@@ -0,0 +1,189 @@
+package inject
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+const proxyLogEnvVar = "LINKERD2_PROXY_LOG"
+
+// Drift is a field of the proxy configuration of an injected pod that differs
+// from what injecting its workload would produce now.
+type Drift struct {
+	Field    string
+	Current  string
+	Expected string
+}
+
+// ErrAlreadyInjected is returned by ProxyDrift when the pod template of the
+// workload already contains the proxy, so that the pods were not injected by
+// the proxy injector and their configuration can't be recomputed.
+var ErrAlreadyInjected = errors.New("the workload's pod template is already injected")
+
+// ProxyDrift injects the pod template of the workload in conf, which must be
+// the workload owning pod, and returns the fields of the pod's proxy
+// configuration that differ from the injected template: the proxy image, log
+// level and resources, and the ports skipped by proxy-init.
+func (conf *ResourceConfig) ProxyDrift(pod *corev1.Pod) ([]Drift, error) {
+	if conf.pod.spec == nil {
+		return nil, fmt.Errorf("unsupported workload kind %s", conf.workload.metaType.Kind)
+	}
+	if containerByName(conf.pod.spec.Containers, k8s.ProxyContainerName) != nil {
+		return nil, ErrAlreadyInjected
+	}
+
+	current := containerByName(pod.Spec.Containers, k8s.ProxyContainerName)
+	if current == nil {
+		return nil, fmt.Errorf("pod %s/%s has no %s container", pod.Namespace, pod.Name, k8s.ProxyContainerName)
+	}
+
+	expectedSpec, err := conf.injectedPodSpec()
+	if err != nil {
+		return nil, err
+	}
+	expected := containerByName(expectedSpec.Containers, k8s.ProxyContainerName)
+	if expected == nil {
+		return nil, errors.New("injection produced no proxy container")
+	}
+
+	drift := []Drift{}
+	add := func(field, current, expected string) {
+		if current != expected {
+			drift = append(drift, Drift{field, current, expected})
+		}
+	}
+
+	add("proxy image", current.Image, expected.Image)
+	add("log level", envValue(current, proxyLogEnvVar), envValue(expected, proxyLogEnvVar))
+	if !equality.Semantic.DeepEqual(current.Resources, expected.Resources) {
+		drift = append(drift, Drift{"resources", formatResources(current.Resources), formatResources(expected.Resources)})
+	}
+
+	// With the CNI plugin, the skipped ports are read from the pod annotations
+	// rather than from the proxy-init arguments.
+	currentInit := containerByName(pod.Spec.InitContainers, k8s.InitContainerName)
+	expectedInit := containerByName(expectedSpec.InitContainers, k8s.InitContainerName)
+	if currentInit != nil && expectedInit != nil {
+		add("inbound skip ports", argValue(currentInit, "--inbound-ports-to-ignore"), argValue(expectedInit, "--inbound-ports-to-ignore"))
+		add("outbound skip ports", argValue(currentInit, "--outbound-ports-to-ignore"), argValue(expectedInit, "--outbound-ports-to-ignore"))
+	}
+
+	return drift, nil
+}
+
+// injectedPodSpec returns the pod spec of the workload once injected with
+// the proxy.
+func (conf *ResourceConfig) injectedPodSpec() (*corev1.PodSpec, error) {
+	patchJSON, err := conf.GetPatch(true)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := jsonpatch.DecodePatch(patchJSON)
+	if err != nil {
+		return nil, err
+	}
+	origJSON, err := json.Marshal(conf.workload.obj)
+	if err != nil {
+		return nil, err
+	}
+	injectedJSON, err := patch.Apply(origJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := conf.getFreshWorkloadObj()
+	if err := json.Unmarshal(injectedJSON, obj); err != nil {
+		return nil, err
+	}
+	switch v := obj.(type) {
+	case *appsv1.Deployment:
+		return &v.Spec.Template.Spec, nil
+	case *corev1.ReplicationController:
+		return &v.Spec.Template.Spec, nil
+	case *appsv1.ReplicaSet:
+		return &v.Spec.Template.Spec, nil
+	case *batchv1.Job:
+		return &v.Spec.Template.Spec, nil
+	case *appsv1.DaemonSet:
+		return &v.Spec.Template.Spec, nil
+	case *appsv1.StatefulSet:
+		return &v.Spec.Template.Spec, nil
+	case *batchv1beta1.CronJob:
+		return &v.Spec.JobTemplate.Spec.Template.Spec, nil
+	case *corev1.Pod:
+		return &v.Spec, nil
+	}
+	return nil, fmt.Errorf("unsupported workload kind %s", conf.workload.metaType.Kind)
+}
+
+func containerByName(containers []corev1.Container, name string) *corev1.Container {
+	for i := range containers {
+		if containers[i].Name == name {
+			return &containers[i]
+		}
+	}
+	return nil
+}
+
+func envValue(container *corev1.Container, name string) string {
+	for _, env := range container.Env {
+		if env.Name == name {
+			return env.Value
+		}
+	}
+	return ""
+}
+
+// argValue returns the value following flag in the container's arguments.
+func argValue(container *corev1.Container, flag string) string {
+	for i, arg := range container.Args {
+		if arg == flag && i+1 < len(container.Args) {
+			return container.Args[i+1]
+		}
+		if strings.HasPrefix(arg, flag+"=") {
+			return strings.TrimPrefix(arg, flag+"=")
+		}
+	}
+	return ""
+}
+
+// formatResources formats resource requirements as e.g.
+// "requests: cpu=100m,memory=20Mi; limits: memory=250Mi".
+func formatResources(r corev1.ResourceRequirements) string {
+	format := func(list corev1.ResourceList) string {
+		names := []string{}
+		for name := range list {
+			names = append(names, string(name))
+		}
+		sort.Strings(names)
+		values := []string{}
+		for _, name := range names {
+			q := list[corev1.ResourceName(name)]
+			values = append(values, fmt.Sprintf("%s=%s", name, q.String()))
+		}
+		return strings.Join(values, ",")
+	}
+
+	parts := []string{}
+	if len(r.Requests) > 0 {
+		parts = append(parts, fmt.Sprintf("requests: %s", format(r.Requests)))
+	}
+	if len(r.Limits) > 0 {
+		parts = append(parts, fmt.Sprintf("limits: %s", format(r.Limits)))
+	}
+	if len(parts) == 0 {
+		return "none"
+	}
+	return strings.Join(parts, "; ")
+}
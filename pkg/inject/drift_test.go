@@ -0,0 +1,123 @@
+package inject
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/linkerd/linkerd2/controller/gen/config"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const driftReplicaSet = `apiVersion: apps/v1
+kind: ReplicaSet
+metadata:
+  name: web-5d8f9
+  namespace: emojivoto
+spec:
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - name: web
+        image: buoyantio/emojivoto-web:v11`
+
+func driftConfigs(proxyVersion, logLevel, memoryLimit, ignoreOutboundPorts string) *config.All {
+	return &config.All{
+		Global: &config.Global{
+			LinkerdNamespace: "linkerd",
+			IdentityContext:  &config.IdentityContext{TrustDomain: "cluster.local", TrustAnchorsPem: "trust anchors"},
+			ClusterDomain:    "cluster.local",
+		},
+		Proxy: &config.Proxy{
+			ProxyImage:          &config.Image{ImageName: "ghcr.io/linkerd/proxy", PullPolicy: "IfNotPresent"},
+			ProxyInitImage:      &config.Image{ImageName: "ghcr.io/linkerd/proxy-init", PullPolicy: "IfNotPresent"},
+			ControlPort:         &config.Port{Port: 4190},
+			InboundPort:         &config.Port{Port: 4143},
+			AdminPort:           &config.Port{Port: 4191},
+			OutboundPort:        &config.Port{Port: 4140},
+			IgnoreOutboundPorts: ToPortRanges([]string{ignoreOutboundPorts}),
+			Resource:            &config.ResourceRequirements{RequestCpu: "100m", LimitMemory: memoryLimit},
+			ProxyUid:            2102,
+			LogLevel:            &config.LogLevel{Level: logLevel},
+			ProxyVersion:        proxyVersion,
+		},
+	}
+}
+
+// injectedPod returns a pod of the replica set, injected with configs.
+func injectedPod(t *testing.T, configs *config.All) *corev1.Pod {
+	t.Helper()
+	conf := NewResourceConfig(configs, OriginWebhook).WithKind("ReplicaSet")
+	if _, err := conf.ParseMetaAndYAML([]byte(driftReplicaSet)); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	spec, err := conf.injectedPodSpec()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return &corev1.Pod{Spec: *spec}
+}
+
+func TestProxyDrift(t *testing.T) {
+	current := driftConfigs("stable-2.9.0", "warn,linkerd=info", "250Mi", "")
+
+	testCases := []struct {
+		name     string
+		configs  *config.All
+		expected []Drift
+	}{
+		{
+			"no drift",
+			current,
+			[]Drift{},
+		},
+		{
+			"drift",
+			driftConfigs("stable-2.9.1", "warn,linkerd=debug", "500Mi", "3306"),
+			[]Drift{
+				{"proxy image", "ghcr.io/linkerd/proxy:stable-2.9.0", "ghcr.io/linkerd/proxy:stable-2.9.1"},
+				{"log level", "warn,linkerd=info", "warn,linkerd=debug"},
+				{"resources", "requests: cpu=100m; limits: memory=250Mi", "requests: cpu=100m; limits: memory=500Mi"},
+				{"outbound skip ports", "", "3306"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			pod := injectedPod(t, current)
+
+			conf := NewResourceConfig(tc.configs, OriginWebhook).WithKind("ReplicaSet")
+			if _, err := conf.ParseMetaAndYAML([]byte(driftReplicaSet)); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			drift, err := conf.ProxyDrift(pod)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(drift, tc.expected) {
+				t.Fatalf("Expected drift %v, got %v", tc.expected, drift)
+			}
+		})
+	}
+}
+
+func TestProxyDriftAlreadyInjected(t *testing.T) {
+	configs := driftConfigs("stable-2.9.0", "warn,linkerd=info", "250Mi", "")
+	pod := injectedPod(t, configs)
+
+	conf := NewResourceConfig(configs, OriginWebhook).WithKind("Pod")
+	if _, err := conf.ParseMetaAndYAML([]byte("apiVersion: v1\nkind: Pod\nmetadata:\n  name: web\n")); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	conf.pod.spec = &pod.Spec
+	if _, err := conf.ProxyDrift(pod); err != ErrAlreadyInjected {
+		t.Fatalf("Expected %s, got %v", ErrAlreadyInjected, err)
+	}
+}
@@ -591,6 +591,10 @@ func (conf *ResourceConfig) injectPodSpec(values *patch) {
 	}
 	values.Global.IdentityTrustAnchorsPEM = idctx.GetTrustAnchorsPem()
 	values.Global.IdentityTrustDomain = idctx.GetTrustDomain()
+	values.Global.Proxy.IdentityToken = &l5dcharts.IdentityToken{
+		Audience:          conf.identityTokenAudience(),
+		ExpirationSeconds: conf.identityTokenExpirationSeconds(),
+	}
 	values.Identity = &l5dcharts.Identity{}
 
 }
@@ -648,6 +652,21 @@ func (conf *ResourceConfig) serviceAccountVolumeMount() *corev1.VolumeMount {
 	return nil
 }
 
+// identityTokenAudience returns the audience of the projected service account
+// token the proxy presents to the identity controller, which only accepts the
+// audience it's configured with. If it is empty, the proxy presents the pod's
+// legacy service account token instead.
+func (conf *ResourceConfig) identityTokenAudience() string {
+	return conf.configs.GetProxy().GetIdentityTokenAudience()
+}
+
+func (conf *ResourceConfig) identityTokenExpirationSeconds() int64 {
+	if seconds := conf.configs.GetProxy().GetIdentityTokenExpirationSeconds(); seconds > 0 {
+		return seconds
+	}
+	return k8s.DefaultIdentityTokenExpirationSeconds
+}
+
 func (conf *ResourceConfig) trace() *l5dcharts.Trace {
 	var (
 		svcAddr    = conf.getOverride(k8s.ProxyTraceCollectorSvcAddrAnnotation)
@@ -683,3 +683,54 @@ func TestConfigAccessors(t *testing.T) {
 		})
 	}
 }
+
+func TestIdentityToken(t *testing.T) {
+	testCases := []struct {
+		name              string
+		audience          string
+		expectedTokenFile string
+	}{
+		{"configured audience", "identity.example.com", "/var/run/secrets/tokens/linkerd-identity-token"},
+		{"legacy token", "", k8s.IdentityServiceAccountTokenPath},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			configs := driftConfigs("stable-2.9.0", "warn,linkerd=info", "250Mi", "")
+			configs.Proxy.IdentityTokenAudience = tc.audience
+			pod := injectedPod(t, configs)
+
+			var projected *corev1.ProjectedVolumeSource
+			for _, v := range pod.Spec.Volumes {
+				if v.Name == k8s.IdentityTokenVolumeName {
+					projected = v.Projected
+				}
+			}
+			if tc.audience == "" {
+				if projected != nil {
+					t.Fatalf("Expected no projected token volume, got %+v", projected)
+				}
+			} else {
+				if projected == nil {
+					t.Fatal("Expected a projected token volume")
+				}
+				if aud := projected.Sources[0].ServiceAccountToken.Audience; aud != tc.audience {
+					t.Fatalf("Expected the token to be bound to %s, got %s", tc.audience, aud)
+				}
+			}
+
+			tokenFile := ""
+			for _, c := range pod.Spec.Containers {
+				for _, env := range c.Env {
+					if env.Name == "LINKERD2_PROXY_IDENTITY_TOKEN_FILE" {
+						tokenFile = env.Value
+					}
+				}
+			}
+			if tokenFile != tc.expectedTokenFile {
+				t.Fatalf("Expected the proxy to present %s, got %s", tc.expectedTokenFile, tokenFile)
+			}
+		})
+	}
+}
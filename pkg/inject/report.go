@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/linkerd/linkerd2/pkg/healthcheck"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	v1 "k8s.io/api/core/v1"
 )
@@ -87,7 +86,7 @@ func newReport(conf *ResourceConfig) *Report {
 	if conf.pod.meta != nil && conf.pod.spec != nil {
 		report.InjectDisabled, report.InjectDisabledReason, report.InjectAnnotationAt = report.disableByAnnotation(conf)
 		report.HostNetwork = conf.pod.spec.HostNetwork
-		report.Sidecar = healthcheck.HasExistingSidecars(conf.pod.spec)
+		report.Sidecar = HasExistingSidecars(conf.pod.spec)
 		report.UDP = checkUDPPorts(conf.pod.spec)
 		report.TracingEnabled = conf.pod.meta.Annotations[k8s.ProxyTraceCollectorSvcAddrAnnotation] != "" || conf.nsAnnotations[k8s.ProxyTraceCollectorSvcAddrAnnotation] != ""
 		if conf.pod.spec.AutomountServiceAccountToken != nil {
@@ -3,6 +3,8 @@ package issuercerts
 import (
 	"context"
 	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
 	"crypto/x509"
 	"fmt"
 	"io/ioutil"
@@ -19,6 +21,9 @@ import (
 const keyMissingError = "key %s containing the %s needs to exist in secret %s if --identity-external-issuer=%v"
 const expirationWarningThresholdInDays = 60
 
+// minRSAKeySize is the smallest RSA key accepted for trust anchors and issuers
+const minRSAKeySize = 2048
+
 // IssuerCertData holds the trust anchors cert data used by the CA
 type IssuerCertData struct {
 	TrustAnchors string
@@ -125,23 +130,29 @@ func CheckExpiringSoon(cert *x509.Certificate) error {
 // CheckCertAlgoRequirements ensures the certificate respects with the constraints
 // we have posed on the public key and signature algorithms
 func CheckCertAlgoRequirements(cert *x509.Certificate) error {
-	if cert.PublicKeyAlgorithm == x509.ECDSA {
-		// this is a safe cast here as we know we are using ECDSA
-		k, ok := cert.PublicKey.(*ecdsa.PublicKey)
-		if !ok {
-			return fmt.Errorf("expected ecdsa.PublicKey but got something %v", cert.PublicKey)
-		}
+	switch k := cert.PublicKey.(type) {
+	case *ecdsa.PublicKey:
 		if k.Params().BitSize != 256 {
 			return fmt.Errorf("must use P-256 curve for public key, instead P-%d was used", k.Params().BitSize)
 		}
-	} else {
-		return fmt.Errorf("must use ECDSA for public key algorithm, instead %s was used", cert.PublicKeyAlgorithm)
+	case *rsa.PublicKey:
+		if k.N.BitLen() < minRSAKeySize {
+			return fmt.Errorf("must use RSA keys of at least %d bits, instead %d bits were used", minRSAKeySize, k.N.BitLen())
+		}
+	case ed25519.PublicKey:
+	default:
+		return fmt.Errorf("must use ECDSA, RSA or Ed25519 for public key algorithm, instead %s was used", cert.PublicKeyAlgorithm)
 	}
 
-	if cert.SignatureAlgorithm != x509.ECDSAWithSHA256 {
-		return fmt.Errorf("must be signed by an ECDSA P-256 key, instead %s was used", cert.SignatureAlgorithm)
+	switch cert.SignatureAlgorithm {
+	case x509.ECDSAWithSHA256,
+		x509.SHA256WithRSA, x509.SHA384WithRSA, x509.SHA512WithRSA,
+		x509.SHA256WithRSAPSS, x509.SHA384WithRSAPSS, x509.SHA512WithRSAPSS,
+		x509.PureEd25519:
+		return nil
+	default:
+		return fmt.Errorf("must be signed by an ECDSA P-256, RSA or Ed25519 key, instead %s was used", cert.SignatureAlgorithm)
 	}
-	return nil
 }
 
 // VerifyAndBuildCreds builds and validates the creds out of the data in IssuerCertData
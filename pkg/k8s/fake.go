@@ -90,7 +90,7 @@ func NewFakeClientSets(configs ...string) (
 			apiRegObjs = append(apiRegObjs, obj)
 		case "apiresourcelist":
 			discoveryObjs = append(discoveryObjs, obj)
-		case ServiceProfile:
+		case ServiceProfile, strings.ToLower(IdentityDenyListKind), strings.ToLower(ExternalWorkloadKind), strings.ToLower(ServerAuthorizationKind):
 			spObjs = append(spObjs, obj)
 		case TrafficSplit:
 			tsObjs = append(tsObjs, obj)
@@ -33,6 +33,18 @@ const (
 	LinkAPIGroupVersion = "multicluster.linkerd.io/v1alpha1"
 	LinkKind            = "Link"
 
+	IdentityDenyListAPIGroup        = "identity.linkerd.io"
+	IdentityDenyListAPIGroupVersion = "identity.linkerd.io/v1alpha1"
+	IdentityDenyListKind            = "IdentityDenyList"
+
+	ExternalWorkloadAPIGroup        = "workload.linkerd.io"
+	ExternalWorkloadAPIGroupVersion = "workload.linkerd.io/v1alpha1"
+	ExternalWorkloadKind            = "ExternalWorkload"
+
+	ServerAuthorizationAPIGroup        = "policy.linkerd.io"
+	ServerAuthorizationAPIGroupVersion = "policy.linkerd.io/v1alpha1"
+	ServerAuthorizationKind            = "ServerAuthorization"
+
 	// special case k8s job label, to not conflict with Prometheus' job label
 	l5dJob = "k8s_job"
 )
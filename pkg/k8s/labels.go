@@ -90,6 +90,14 @@ const (
 	// issuer credentials will cease to be valid.
 	IdentityIssuerExpiryAnnotation = Prefix + "/identity-issuer-expiry"
 
+	// IdentityRotationPhaseAnnotation records the phase an ongoing trust
+	// anchor and issuer rotation has reached, so that it can be resumed.
+	IdentityRotationPhaseAnnotation = Prefix + "/identity-rotation-phase"
+
+	// IdentityRotationIssuerSwappedAnnotation records the time at which the
+	// new issuer was first observed during a trust anchor rotation.
+	IdentityRotationIssuerSwappedAnnotation = Prefix + "/identity-rotation-issuer-swapped-at"
+
 	// ProxyVersionAnnotation indicates the version of the injected data plane
 	// (e.g. v0.1.3).
 	ProxyVersionAnnotation = Prefix + "/proxy-version"
@@ -284,6 +292,11 @@ const (
 	// volume mounted into each proxy to store identity credentials.
 	IdentityEndEntityVolumeName = "linkerd-identity-end-entity"
 
+	// IdentityTokenVolumeName is the name of the projected volume holding the
+	// audience-bound service account token used by proxies to provision
+	// identity.
+	IdentityTokenVolumeName = "linkerd-identity-token"
+
 	// PodInfoVolumeName is the name assigned to the
 	// volume mounted into each proxy to store pod labels.
 	PodInfoVolumeName = "podinfo"
@@ -291,6 +304,17 @@ const (
 	// IdentityIssuerSecretName is the name of the Secret that stores issuer credentials.
 	IdentityIssuerSecretName = "linkerd-identity-issuer"
 
+	// IdentityRotationSecretName is the name of the Secret that stores the new
+	// trust anchor and issuer credentials during a trust anchor rotation.
+	IdentityRotationSecretName = "linkerd-identity-rotation"
+
+	// IdentityJoinKeySecretName is the name of the Secret that stores the key
+	// external workloads' join tokens are signed with.
+	IdentityJoinKeySecretName = "linkerd-identity-join-key"
+
+	// IdentityJoinKeyName is the join key's file.
+	IdentityJoinKeyName = "key"
+
 	// IdentityIssuerSchemeLinkerd is the issuer secret scheme used by linkerd
 	IdentityIssuerSchemeLinkerd = "linkerd.io/tls"
 
@@ -364,12 +388,16 @@ const (
 	// This is necessary for xtables-legacy support
 	MountPathXtablesLock = "/run"
 
-	// IdentityServiceAccountTokenPath is the path to the kubernetes service
-	// account token used by proxies to provision identity.
-	//
-	// In the future, this should be changed to a time- and audience-scoped secret.
+	// IdentityServiceAccountTokenPath is the path to the legacy kubernetes
+	// service account token used by proxies to provision identity when no
+	// projected token is mounted.
 	IdentityServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
 
+	// DefaultIdentityTokenExpirationSeconds is the requested lifetime of
+	// projected identity tokens. The kubelet refreshes them before they
+	// expire.
+	DefaultIdentityTokenExpirationSeconds = 3600
+
 	/*
 	 * Service mirror constants
 	 */
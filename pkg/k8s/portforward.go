@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -200,6 +201,12 @@ func (pf *PortForward) URLFor(path string) string {
 	return fmt.Sprintf("http://%s:%d%s", pf.host, pf.localPort, path)
 }
 
+// AddressAndPort returns the address and port of the local end of the
+// port-forward connection.
+func (pf *PortForward) AddressAndPort() string {
+	return net.JoinHostPort(pf.host, strconv.Itoa(pf.localPort))
+}
+
 // getEphemeralPort selects a port for the port-forwarding. It binds to a free
 // ephemeral port and returns the port number.
 func getEphemeralPort() (int, error) {
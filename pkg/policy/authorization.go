@@ -0,0 +1,197 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	authz "github.com/linkerd/linkerd2/controller/gen/apis/policy/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// AnyIdentity authorizes all the mesh identities when listed in a
+// ServerAuthorization's identities
+const AnyIdentity = "*"
+
+// Validate validates the serialized ServerAuthorization
+func Validate(data []byte) error {
+	var serverAuthz authz.ServerAuthorization
+	if err := yaml.UnmarshalStrict(data, &serverAuthz); err != nil {
+		return fmt.Errorf("failed to validate ServerAuthorization: %s", err)
+	}
+	return ValidateServerAuthorization(&serverAuthz)
+}
+
+// ValidateServerAuthorization returns an error describing the first invalid
+// field of a ServerAuthorization, if any
+func ValidateServerAuthorization(serverAuthz *authz.ServerAuthorization) error {
+	if _, err := metav1.LabelSelectorAsSelector(&serverAuthz.Spec.PodSelector); err != nil {
+		return fmt.Errorf("ServerAuthorization \"%s\" has an invalid pod selector: %s", serverAuthz.Name, err)
+	}
+
+	for _, port := range serverAuthz.Spec.Ports {
+		if port.Type == intstr.Int {
+			if errs := validation.IsValidPortNum(port.IntValue()); len(errs) > 0 {
+				return fmt.Errorf("ServerAuthorization \"%s\" has an invalid port %d: %s", serverAuthz.Name, port.IntValue(), errs[0])
+			}
+			continue
+		}
+		if errs := validation.IsValidPortName(port.StrVal); len(errs) > 0 {
+			return fmt.Errorf("ServerAuthorization \"%s\" has an invalid port name \"%s\": %s", serverAuthz.Name, port.StrVal, errs[0])
+		}
+	}
+
+	client := serverAuthz.Spec.Client
+	if client.MeshTLS == nil {
+		if !client.Unauthenticated {
+			return fmt.Errorf("ServerAuthorization \"%s\" authorizes no clients: set either client.meshTLS or client.unauthenticated", serverAuthz.Name)
+		}
+		return nil
+	}
+
+	meshTLS := client.MeshTLS
+	if len(meshTLS.Identities)+len(meshTLS.ServiceAccounts)+len(meshTLS.Namespaces) == 0 {
+		return fmt.Errorf("ServerAuthorization \"%s\" has no identities, service accounts nor namespaces in client.meshTLS", serverAuthz.Name)
+	}
+	for _, id := range meshTLS.Identities {
+		if id == AnyIdentity {
+			continue
+		}
+		if errs := validation.IsDNS1123Subdomain(id); len(errs) > 0 {
+			return fmt.Errorf("ServerAuthorization \"%s\" has an invalid identity \"%s\": %s", serverAuthz.Name, id, errs[0])
+		}
+	}
+	for _, sa := range meshTLS.ServiceAccounts {
+		if errs := validation.IsDNS1123Label(sa.Name); len(errs) > 0 {
+			return fmt.Errorf("ServerAuthorization \"%s\" has an invalid service account name \"%s\": %s", serverAuthz.Name, sa.Name, errs[0])
+		}
+		if sa.Namespace == "" {
+			continue
+		}
+		if errs := validation.IsDNS1123Label(sa.Namespace); len(errs) > 0 {
+			return fmt.Errorf("ServerAuthorization \"%s\" has an invalid service account namespace \"%s\": %s", serverAuthz.Name, sa.Namespace, errs[0])
+		}
+	}
+	for _, ns := range meshTLS.Namespaces {
+		if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+			return fmt.Errorf("ServerAuthorization \"%s\" has an invalid namespace \"%s\": %s", serverAuthz.Name, ns, errs[0])
+		}
+	}
+
+	return nil
+}
+
+// SelectsPod returns true if the ServerAuthorization applies to the given pod,
+// for at least one of its ports
+func SelectsPod(serverAuthz *authz.ServerAuthorization, pod *corev1.Pod) bool {
+	if serverAuthz.Namespace != pod.Namespace {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&serverAuthz.Spec.PodSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(pod.Labels))
+}
+
+// SelectsPort returns true if the ServerAuthorization applies to connections to
+// the given port of a pod. Named ports are resolved against the pod's
+// container ports
+func SelectsPort(serverAuthz *authz.ServerAuthorization, pod *corev1.Pod, port uint32) bool {
+	if !SelectsPod(serverAuthz, pod) {
+		return false
+	}
+	if len(serverAuthz.Spec.Ports) == 0 {
+		return true
+	}
+	for _, p := range serverAuthz.Spec.Ports {
+		if p.Type == intstr.Int {
+			if uint32(p.IntValue()) == port {
+				return true
+			}
+			continue
+		}
+		for _, c := range pod.Spec.Containers {
+			for _, cp := range c.Ports {
+				if cp.Name == p.StrVal && uint32(cp.ContainerPort) == port {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Authorizes returns true if the ServerAuthorization allows connections from a
+// client with the given DNS-form mesh identity. Clients without an identity are
+// represented by an empty string. Service accounts and namespaces only match
+// the identities issued by the control plane in controlPlaneNS for
+// trustDomain
+func Authorizes(serverAuthz *authz.ServerAuthorization, clientID, controlPlaneNS, trustDomain string) bool {
+	client := serverAuthz.Spec.Client
+	if client.Unauthenticated {
+		return true
+	}
+	if clientID == "" || client.MeshTLS == nil {
+		return false
+	}
+
+	for _, id := range client.MeshTLS.Identities {
+		if id == AnyIdentity || id == clientID {
+			return true
+		}
+	}
+
+	// Without a trust domain, no identities are issued by this control plane.
+	if trustDomain == "" {
+		return false
+	}
+
+	// web.emojivoto.serviceaccount.identity.linkerd.cluster.local
+	suffix := fmt.Sprintf(".serviceaccount.identity.%s.%s", controlPlaneNS, trustDomain)
+	if !strings.HasSuffix(clientID, suffix) {
+		return false
+	}
+	parts := strings.Split(strings.TrimSuffix(clientID, suffix), ".")
+	if len(parts) != 2 {
+		return false
+	}
+	name, ns := parts[0], parts[1]
+	for _, sa := range client.MeshTLS.ServiceAccounts {
+		saNs := sa.Namespace
+		if saNs == "" {
+			saNs = serverAuthz.Namespace
+		}
+		if sa.Name == name && saNs == ns {
+			return true
+		}
+	}
+	for _, n := range client.MeshTLS.Namespaces {
+		if n == ns {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorized returns true if a client with the given DNS-form mesh identity may
+// connect to the given port of a pod, according to the ServerAuthorizations of
+// the pod's namespace. Ports that no ServerAuthorization selects accept all
+// clients
+func Authorized(serverAuthzs []*authz.ServerAuthorization, pod *corev1.Pod, port uint32, clientID, controlPlaneNS, trustDomain string) bool {
+	selected := false
+	for _, serverAuthz := range serverAuthzs {
+		if !SelectsPort(serverAuthz, pod, port) {
+			continue
+		}
+		if Authorizes(serverAuthz, clientID, controlPlaneNS, trustDomain) {
+			return true
+		}
+		selected = true
+	}
+	return !selected
+}
@@ -0,0 +1,236 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	authz "github.com/linkerd/linkerd2/controller/gen/apis/policy/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/yaml"
+)
+
+func TestValidate(t *testing.T) {
+	expectations := []struct {
+		authz string
+		err   error
+	}{
+		{
+			authz: `apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: web
+  namespace: emojivoto
+spec:
+  podSelector:
+    matchLabels:
+      app: web-svc
+  ports:
+  - 8080
+  - http
+  client:
+    meshTLS:
+      identities:
+      - vote-bot.emojivoto.serviceaccount.identity.linkerd.cluster.local
+      serviceAccounts:
+      - name: web
+      namespaces:
+      - linkerd`,
+		},
+		{
+			authz: `apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: admin
+  namespace: emojivoto
+spec:
+  podSelector: {}
+  client:
+    unauthenticated: true`,
+		},
+		{
+			authz: `apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: web
+  namespace: emojivoto
+spec:
+  podSelector: {}
+  client:
+    unauthenticated: true
+    mtls: {}`,
+			err: errors.New("failed to validate ServerAuthorization: error unmarshaling JSON: while decoding JSON: json: unknown field \"mtls\""),
+		},
+		{
+			authz: `apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: web
+  namespace: emojivoto
+spec:
+  podSelector: {}
+  client: {}`,
+			err: errors.New("ServerAuthorization \"web\" authorizes no clients: set either client.meshTLS or client.unauthenticated"),
+		},
+		{
+			authz: `apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: web
+  namespace: emojivoto
+spec:
+  podSelector: {}
+  client:
+    meshTLS: {}`,
+			err: errors.New("ServerAuthorization \"web\" has no identities, service accounts nor namespaces in client.meshTLS"),
+		},
+		{
+			authz: `apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: web
+  namespace: emojivoto
+spec:
+  podSelector: {}
+  ports:
+  - 70000
+  client:
+    unauthenticated: true`,
+			err: errors.New("ServerAuthorization \"web\" has an invalid port 70000: must be between 1 and 65535, inclusive"),
+		},
+		{
+			authz: `apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: web
+  namespace: emojivoto
+spec:
+  podSelector:
+    matchExpressions:
+    - key: app
+      operator: Bogus
+  client:
+    unauthenticated: true`,
+			err: errors.New("ServerAuthorization \"web\" has an invalid pod selector: \"Bogus\" is not a valid pod selector operator"),
+		},
+		{
+			authz: `apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: web
+  namespace: emojivoto
+spec:
+  podSelector: {}
+  client:
+    meshTLS:
+      namespaces:
+      - Emojivoto`,
+			err: errors.New("ServerAuthorization \"web\" has an invalid namespace \"Emojivoto\": a DNS-1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+		},
+	}
+
+	for i, exp := range expectations {
+		exp := exp // pin
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			err := Validate([]byte(exp.authz))
+			if exp.err == nil {
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != exp.err.Error() {
+				t.Fatalf("Expected error: %s, got: %v", exp.err, err)
+			}
+		})
+	}
+}
+
+func TestAuthorized(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-1",
+			Namespace: "emojivoto",
+			Labels:    map[string]string{"app": "web-svc"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Ports: []corev1.ContainerPort{
+					{Name: "http", ContainerPort: 8080},
+					{Name: "admin-http", ContainerPort: 9990},
+				},
+			}},
+		},
+	}
+
+	var serverAuthz authz.ServerAuthorization
+	err := yaml.Unmarshal([]byte(`apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: web
+  namespace: emojivoto
+spec:
+  podSelector:
+    matchLabels:
+      app: web-svc
+  ports:
+  - http
+  client:
+    meshTLS:
+      identities:
+      - vote-bot.emojivoto.serviceaccount.identity.linkerd.cluster.local
+      serviceAccounts:
+      - name: web
+      - name: prometheus
+        namespace: monitoring
+      namespaces:
+      - linkerd`), &serverAuthz)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	serverAuthzs := []*authz.ServerAuthorization{&serverAuthz}
+
+	testCases := []struct {
+		name       string
+		port       uint32
+		clientID   string
+		authorized bool
+	}{
+		{"identity", 8080, "vote-bot.emojivoto.serviceaccount.identity.linkerd.cluster.local", true},
+		{"local service account", 8080, "web.emojivoto.serviceaccount.identity.linkerd.cluster.local", true},
+		{"remote service account", 8080, "prometheus.monitoring.serviceaccount.identity.linkerd.cluster.local", true},
+		{"namespace", 8080, "linkerd-web.linkerd.serviceaccount.identity.linkerd.cluster.local", true},
+		{"other service account", 8080, "emoji.emojivoto.serviceaccount.identity.linkerd.cluster.local", false},
+		{"service account of another trust domain", 8080, "web.emojivoto.serviceaccount.identity.linkerd.example.com", false},
+		{"service account of another control plane", 8080, "web.emojivoto.serviceaccount.identity.other.cluster.local", false},
+		{"service account lookalike", 8080, "web.emojivoto.serviceaccount.evil.example.com", false},
+		{"unauthenticated", 8080, "", false},
+		{"unselected port", 9990, "", true},
+	}
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			if authorized := Authorized(serverAuthzs, pod, tc.port, tc.clientID, "linkerd", "cluster.local"); authorized != tc.authorized {
+				t.Fatalf("Expected authorized to be %t, got %t", tc.authorized, authorized)
+			}
+		})
+	}
+
+	t.Run("unselected pod", func(t *testing.T) {
+		other := pod.DeepCopy()
+		other.Labels = map[string]string{"app": "emoji-svc"}
+		if !Authorized(serverAuthzs, other, 8080, "", "linkerd", "cluster.local") {
+			t.Fatal("Expected pods without ServerAuthorizations to accept all clients")
+		}
+	})
+
+	t.Run("port number", func(t *testing.T) {
+		byNumber := serverAuthz.DeepCopy()
+		byNumber.Spec.Ports = []intstr.IntOrString{intstr.FromInt(9990)}
+		if !SelectsPort(byNumber, pod, 9990) || SelectsPort(byNumber, pod, 8080) {
+			t.Fatal("Expected only port 9990 to be selected")
+		}
+	})
+}
@@ -1,9 +1,12 @@
 package tls
 
 import (
+	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"fmt"
@@ -13,7 +16,8 @@ import (
 
 type (
 	// CA provides a certificate authority for TLS-enabled installs.
-	// Issuing certificates concurrently is not supported.
+	// A CA is not modified once created, so it may issue certificates
+	// concurrently.
 	CA struct {
 		// Cred contains the CA's credentials.
 		Cred Cred
@@ -26,16 +30,6 @@ type (
 		// validity.
 		Validity Validity
 
-		// nextSerialNumber is the serial number of the next certificate to issue.
-		// Serial numbers must not be reused.
-		//
-		// It is assumed there is only one instance of CA and it is assumed that a
-		// given CA object isn't requested to issue certificates concurrently.
-		//
-		// For now we do not attempt to meet CABForum requirements (e.g. regarding
-		// randomness).
-		nextSerialNumber uint64
-
 		// firstCrtExpiration is the time when the first expiration of a certificate
 		// in the trust chain occurs
 		firstCrtExpiration time.Time
@@ -87,6 +81,10 @@ const (
 	DefaultClockSkewAllowance = 10 * time.Second
 )
 
+// serialNumberLimit bounds random serial numbers to 128 bits, well within the
+// 20 octets allowed by RFC 5280.
+var serialNumberLimit = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
 // Finds the time at which the first certificate
 // from the chain will expire
 func findFirstExpiration(cred *Cred) time.Time {
@@ -101,7 +99,7 @@ func findFirstExpiration(cred *Cred) time.Time {
 
 // NewCA initializes a new CA with default settings.
 func NewCA(cred Cred, validity Validity) *CA {
-	return &CA{cred, validity, uint64(1), findFirstExpiration(&cred)}
+	return &CA{cred, validity, findFirstExpiration(&cred)}
 }
 
 func init() {
@@ -109,14 +107,26 @@ func init() {
 	var _ Issuer = &CA{}
 }
 
-// CreateRootCA configures a new root CA with the given settings
+// CreateRootCA configures a new root CA with the given settings. The key
+// must be an ECDSA, RSA or Ed25519 private key.
 func CreateRootCA(
 	name string,
-	key *ecdsa.PrivateKey,
+	key crypto.Signer,
 	validity Validity,
 ) (*CA, error) {
+	sigAlg, err := signatureAlgorithm(key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
 	// Configure the root certificate.
-	t := createTemplate(1, &key.PublicKey, validity)
+	t := createTemplate(serialNumber, key.Public(), validity)
+	t.SignatureAlgorithm = sigAlg
 	t.Subject = pkix.Name{CommonName: name}
 	t.DNSNames = []string{name}
 	t.IsCA = true
@@ -136,13 +146,14 @@ func CreateRootCA(
 
 	// The Crt has an empty TrustChain because it's at the root.
 	cred := validCredOrPanic(key, Crt{Certificate: c})
-	ca := NewCA(cred, validity)
-	ca.nextSerialNumber++ // Because we've already created the root cert.
-	return ca, nil
+	return NewCA(cred, validity), nil
 }
 
 // GenerateKey creates a new P-256 ECDSA private key from the default random
 // source.
+//
+// ECDSA is used by default instead of RSA because ECDSA key generation is
+// straightforward and fast whereas RSA key generation is extremely slow.
 func GenerateKey() (*ecdsa.PrivateKey, error) {
 	return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 }
@@ -165,7 +176,20 @@ func (ca *CA) GenerateCA(name string, maxPathLen int) (*CA, error) {
 		return nil, err
 	}
 
-	t := ca.createTemplate(&key.PublicKey)
+	return ca.CreateCA(name, key, maxPathLen)
+}
+
+// CreateCA creates a new intermediate CA for the given key, which must be an
+// ECDSA, RSA or Ed25519 private key.
+func (ca *CA) CreateCA(name string, key crypto.Signer, maxPathLen int) (*CA, error) {
+	if _, err := NewGenericPrivateKey(key); err != nil {
+		return nil, err
+	}
+
+	t, err := ca.createTemplate(key.Public())
+	if err != nil {
+		return nil, err
+	}
 	t.Subject = pkix.Name{CommonName: name}
 	t.DNSNames = []string{name}
 	t.IsCA = true
@@ -206,12 +230,16 @@ func (ca *CA) GenerateEndEntityCred(dnsName string) (*Cred, error) {
 // IssueEndEntityCrt creates a new certificate that is valid for the
 // given DNS name, generating a new keypair for it.
 func (ca *CA) IssueEndEntityCrt(csr *x509.CertificateRequest) (Crt, error) {
-	pubkey, ok := csr.PublicKey.(*ecdsa.PublicKey)
-	if !ok {
-		return Crt{}, fmt.Errorf("CSR must contain an ECDSA public key: %+v", csr.PublicKey)
+	switch csr.PublicKey.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+	default:
+		return Crt{}, fmt.Errorf("CSR must contain an ECDSA, RSA or Ed25519 public key: %+v", csr.PublicKey)
 	}
 
-	t := ca.createTemplate(pubkey)
+	t, err := ca.createTemplate(csr.PublicKey)
+	if err != nil {
+		return Crt{}, err
+	}
 	t.Issuer = ca.Cred.Crt.Certificate.Subject
 	t.Subject = csr.Subject
 	t.Extensions = csr.Extensions
@@ -227,9 +255,19 @@ func (ca *CA) IssueEndEntityCrt(csr *x509.CertificateRequest) (Crt, error) {
 // createTemplate returns a certificate t for a non-CA certificate with
 // no subject name, no subjectAltNames. The t can then be modified into
 // a (root) CA t or an end-entity t by the caller.
-func (ca *CA) createTemplate(pubkey *ecdsa.PublicKey) *x509.Certificate {
-	c := createTemplate(ca.nextSerialNumber, pubkey, ca.Validity)
-	ca.nextSerialNumber++
+func (ca *CA) createTemplate(pubkey crypto.PublicKey) (*x509.Certificate, error) {
+	sigAlg, err := signatureAlgorithm(ca.Cred.PrivateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	c := createTemplate(serialNumber, pubkey, ca.Validity)
+	c.SignatureAlgorithm = sigAlg
 	// if our trust chain contains a certificate that expires
 	// sooner than the one we intend to issue, we clamp the
 	// NotAfter time of our newly issued certificate. That ensures
@@ -238,30 +276,54 @@ func (ca *CA) createTemplate(pubkey *ecdsa.PublicKey) *x509.Certificate {
 	if ca.firstCrtExpiration.Before(c.NotAfter) {
 		c.NotAfter = ca.firstCrtExpiration
 	}
-	return c
+	return c, nil
+}
+
+// newSerialNumber returns a random, positive serial number of up to 128 bits.
+//
+// Serial numbers must not be reused by an issuer. Drawing them at random
+// rather than from a counter means they don't need to be coordinated between
+// concurrent issuances or across restarts of the identity service, and makes
+// them unpredictable, as recommended by the CA/Browser Forum.
+func newSerialNumber() (*big.Int, error) {
+	n, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %s", err)
+	}
+	return n.Add(n, big.NewInt(1)), nil
+}
+
+// signatureAlgorithm returns the algorithm certificates are signed with by the
+// given issuer key.
+//
+// SHA-256 is used with ECDSA because any larger digest would be truncated to
+// 256 bits anyway since a P-256 scalar is only 256 bits long. RSA keys use
+// PKCS#1 v1.5 signatures, which are the most widely supported, and Ed25519
+// keys can only produce pure Ed25519 signatures.
+func signatureAlgorithm(issuerKey crypto.PublicKey) (x509.SignatureAlgorithm, error) {
+	switch issuerKey.(type) {
+	case *ecdsa.PublicKey:
+		return x509.ECDSAWithSHA256, nil
+	case *rsa.PublicKey:
+		return x509.SHA256WithRSA, nil
+	case ed25519.PublicKey:
+		return x509.PureEd25519, nil
+	default:
+		return x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported issuer key type: %T", issuerKey)
+	}
 }
 
 // createTemplate returns a certificate t for a non-CA certificate with
 // no subject name, no subjectAltNames. The t can then be modified into
 // a (root) CA t or an end-entity t by the caller.
+//
+// The signature algorithm is left for the caller to set, as it depends on the
+// issuer's key rather than on the certificate's.
 func createTemplate(
-	serialNumber uint64,
-	k *ecdsa.PublicKey,
+	serialNumber *big.Int,
+	k crypto.PublicKey,
 	v Validity,
 ) *x509.Certificate {
-	// ECDSA is used instead of RSA because ECDSA key generation is
-	// straightforward and fast whereas RSA key generation is extremely slow
-	// and error-prone.
-	//
-	// CA certificates are signed with the same algorithm as end-entity
-	// certificates because they are relatively short-lived, because using one
-	// algorithm minimizes exposure to implementation flaws, and to speed up
-	// signature verification time.
-	//
-	// SHA-256 is used because any larger digest would be truncated to 256 bits
-	// anyway since a P-256 scalar is only 256 bits long.
-	const SignatureAlgorithm = x509.ECDSAWithSHA256
-
 	if v.ValidFrom == nil {
 		now := time.Now()
 		v.ValidFrom = &now
@@ -269,12 +331,11 @@ func createTemplate(
 	notBefore, notAfter := v.Window(*v.ValidFrom)
 
 	return &x509.Certificate{
-		SerialNumber:       big.NewInt(int64(serialNumber)),
-		SignatureAlgorithm: SignatureAlgorithm,
-		NotBefore:          notBefore,
-		NotAfter:           notAfter,
-		PublicKey:          k,
-		KeyUsage:           x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		SerialNumber: serialNumber,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		PublicKey:    k,
+		KeyUsage:     x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
 		ExtKeyUsage: []x509.ExtKeyUsage{
 			x509.ExtKeyUsageServerAuth,
 			x509.ExtKeyUsageClientAuth,
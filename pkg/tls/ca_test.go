@@ -1,6 +1,17 @@
 package tls
 
 import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sync"
 	"testing"
 	"time"
 )
@@ -65,3 +76,171 @@ func TestCaIssuesCertsWithCorrectExpiration(t *testing.T) {
 	}
 
 }
+
+type unsupportedSigner struct{}
+
+func (unsupportedSigner) Public() crypto.PublicKey { return "unsupported" }
+
+func (unsupportedSigner) Sign(io.Reader, []byte, crypto.SignerOpts) ([]byte, error) {
+	return nil, errors.New("unsupported")
+}
+
+func generateKeyOfType(t *testing.T, keyType string) crypto.Signer {
+	var (
+		key crypto.Signer
+		err error
+	)
+	switch keyType {
+	case "ecdsa":
+		key, err = GenerateKey()
+	case "rsa":
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	case "ed25519":
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		t.Fatalf("Unknown key type %s", keyType)
+	}
+	if err != nil {
+		t.Fatalf("Failed to generate %s key: %s", keyType, err)
+	}
+	return key
+}
+
+func TestCaSupportsKeyTypes(t *testing.T) {
+	expectedSigAlgs := map[string]x509.SignatureAlgorithm{
+		"ecdsa":   x509.ECDSAWithSHA256,
+		"rsa":     x509.SHA256WithRSA,
+		"ed25519": x509.PureEd25519,
+	}
+
+	for _, rootType := range []string{"ecdsa", "rsa", "ed25519"} {
+		for _, issuerType := range []string{"ecdsa", "rsa", "ed25519"} {
+			rootType, issuerType := rootType, issuerType // pin
+			t.Run(fmt.Sprintf("%s root, %s issuer", rootType, issuerType), func(t *testing.T) {
+				root, err := CreateRootCA("root.test", generateKeyOfType(t, rootType), Validity{})
+				if err != nil {
+					t.Fatalf("Failed to create root CA: %s", err)
+				}
+				if root.Cred.Certificate.SignatureAlgorithm != expectedSigAlgs[rootType] {
+					t.Fatalf("Expected root to be signed with %s, got %s", expectedSigAlgs[rootType], root.Cred.Certificate.SignatureAlgorithm)
+				}
+
+				issuer, err := root.CreateCA("issuer.test", generateKeyOfType(t, issuerType), 0)
+				if err != nil {
+					t.Fatalf("Failed to create issuer CA: %s", err)
+				}
+				if issuer.Cred.Certificate.SignatureAlgorithm != expectedSigAlgs[rootType] {
+					t.Fatalf("Expected issuer to be signed with %s, got %s", expectedSigAlgs[rootType], issuer.Cred.Certificate.SignatureAlgorithm)
+				}
+
+				// the issuer's credentials survive a PEM round-trip, as when
+				// they're read from a secret by the identity service
+				cred, err := ValidateAndCreateCreds(issuer.Cred.EncodePEM(), issuer.Cred.EncodePrivateKeyPEM())
+				if err != nil {
+					t.Fatalf("Failed to decode issuer credentials: %s", err)
+				}
+				if _, err := issuer.Cred.EncodePrivateKeyP8(); err != nil {
+					t.Fatalf("Failed to encode issuer key as PKCS#8: %s", err)
+				}
+
+				leaf, err := NewCA(*cred, Validity{}).GenerateEndEntityCred("leaf.test")
+				if err != nil {
+					t.Fatalf("Failed to issue end entity cred: %s", err)
+				}
+				if leaf.Certificate.SignatureAlgorithm != expectedSigAlgs[issuerType] {
+					t.Fatalf("Expected leaf to be signed with %s, got %s", expectedSigAlgs[issuerType], leaf.Certificate.SignatureAlgorithm)
+				}
+				if err := leaf.Verify(root.Cred.CertPool(), "leaf.test", time.Time{}); err != nil {
+					t.Fatalf("Failed to verify leaf against root: %s", err)
+				}
+			})
+		}
+	}
+}
+
+func TestCaIssuesCertsForCSRKeyTypes(t *testing.T) {
+	ca, err := GenerateRootCAWithDefaults("root.test")
+	if err != nil {
+		t.Fatalf("Failed to create root CA: %s", err)
+	}
+
+	for _, keyType := range []string{"ecdsa", "rsa", "ed25519"} {
+		keyType := keyType // pin
+		t.Run(keyType, func(t *testing.T) {
+			key := generateKeyOfType(t, keyType)
+			csr := x509.CertificateRequest{
+				Subject:   pkix.Name{CommonName: "leaf.test"},
+				DNSNames:  []string{"leaf.test"},
+				PublicKey: key.Public(),
+			}
+			crt, err := ca.IssueEndEntityCrt(&csr)
+			if err != nil {
+				t.Fatalf("Failed to issue end entity crt: %s", err)
+			}
+			if err := crt.Verify(ca.Cred.CertPool(), "leaf.test", time.Time{}); err != nil {
+				t.Fatalf("Failed to verify end entity crt: %s", err)
+			}
+		})
+	}
+}
+
+func TestCaRejectsUnsupportedKeys(t *testing.T) {
+	if _, err := CreateRootCA("root.test", unsupportedSigner{}, Validity{}); err == nil {
+		t.Fatal("Expected root CA creation to fail for an unsupported key")
+	}
+
+	ca, err := GenerateRootCAWithDefaults("root.test")
+	if err != nil {
+		t.Fatalf("Failed to create root CA: %s", err)
+	}
+	if _, err := ca.CreateCA("issuer.test", unsupportedSigner{}, 0); err == nil {
+		t.Fatal("Expected issuer CA creation to fail for an unsupported key")
+	}
+
+	csr := x509.CertificateRequest{DNSNames: []string{"leaf.test"}, PublicKey: "unsupported"}
+	if _, err := ca.IssueEndEntityCrt(&csr); err == nil {
+		t.Fatal("Expected issuing a certificate to fail for an unsupported CSR key")
+	}
+}
+
+func TestCaIssuesConcurrentlyWithUniqueSerialNumbers(t *testing.T) {
+	ca, err := GenerateRootCAWithDefaults("root.test")
+	if err != nil {
+		t.Fatalf("Failed to create root CA: %s", err)
+	}
+
+	const issuances = 50
+	serials := make(chan *big.Int, issuances)
+	errs := make(chan error, issuances)
+	var wg sync.WaitGroup
+	for i := 0; i < issuances; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cred, err := ca.GenerateEndEntityCred("leaf.test")
+			if err != nil {
+				errs <- err
+				return
+			}
+			serials <- cred.Certificate.SerialNumber
+		}()
+	}
+	wg.Wait()
+	close(serials)
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("Failed to issue end entity cred: %s", err)
+	}
+
+	seen := map[string]bool{ca.Cred.Certificate.SerialNumber.String(): true}
+	for serial := range serials {
+		if serial.Sign() <= 0 {
+			t.Fatalf("Expected a positive serial number, got %s", serial)
+		}
+		if seen[serial.String()] {
+			t.Fatalf("Serial number %s was issued twice", serial)
+		}
+		seen[serial.String()] = true
+	}
+}
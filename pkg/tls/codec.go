@@ -3,6 +3,7 @@ package tls
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
@@ -74,14 +75,16 @@ func DecodePEMKey(txt string) (GenericPrivateKey, error) {
 		if err != nil {
 			return nil, err
 		}
-		if ec, ok := k.(*ecdsa.PrivateKey); ok {
-			return privateKeyEC{ec}, nil
-		}
-		if rsa, ok := k.(*rsa.PrivateKey); ok {
-			return privateKeyRSA{rsa}, nil
+		switch key := k.(type) {
+		case *ecdsa.PrivateKey:
+			return privateKeyEC{key}, nil
+		case *rsa.PrivateKey:
+			return privateKeyRSA{key}, nil
+		case ed25519.PrivateKey:
+			return privateKeyEd25519{key}, nil
 		}
 		return nil, fmt.Errorf(
-			"unsupported PKCS#8 encoded private key type: '%s', linkerd2 only supports ECDSA and RSA private keys",
+			"unsupported PKCS#8 encoded private key type: '%s', linkerd2 only supports ECDSA, RSA and Ed25519 private keys",
 			reflect.TypeOf(k))
 	default:
 		return nil, fmt.Errorf("unsupported block type: '%s'", block.Type)
@@ -2,7 +2,9 @@ package tls
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -24,10 +26,19 @@ type (
 		*rsa.PrivateKey
 	}
 
-	// GenericPrivateKey represents either an EC or an RSA private key
+	// privateKeyEd25519 wraps an Ed25519 private key
+	privateKeyEd25519 struct {
+		ed25519.PrivateKey
+	}
+
+	// GenericPrivateKey represents either an EC, an RSA or an Ed25519 private
+	// key
 	GenericPrivateKey interface {
+		crypto.Signer
 		matchesCertificate(*x509.Certificate) bool
 		marshal() ([]byte, error)
+		pemType() string
+		unwrap() crypto.Signer
 	}
 
 	// Cred is a container for a certificate, trust chain, and private key.
@@ -55,6 +66,14 @@ func (k privateKeyEC) marshal() ([]byte, error) {
 	return x509.MarshalECPrivateKey(k.PrivateKey)
 }
 
+func (k privateKeyEC) pemType() string {
+	return "EC PRIVATE KEY"
+}
+
+func (k privateKeyEC) unwrap() crypto.Signer {
+	return k.PrivateKey
+}
+
 func (k privateKeyRSA) matchesCertificate(c *x509.Certificate) bool {
 	pub, ok := c.PublicKey.(*rsa.PublicKey)
 	return ok && pub.N.Cmp(k.N) == 0 && pub.E == k.E
@@ -64,9 +83,54 @@ func (k privateKeyRSA) marshal() ([]byte, error) {
 	return x509.MarshalPKCS1PrivateKey(k.PrivateKey), nil
 }
 
+func (k privateKeyRSA) pemType() string {
+	return "RSA PRIVATE KEY"
+}
+
+func (k privateKeyRSA) unwrap() crypto.Signer {
+	return k.PrivateKey
+}
+
+func (k privateKeyEd25519) matchesCertificate(c *x509.Certificate) bool {
+	pub, ok := c.PublicKey.(ed25519.PublicKey)
+	return ok && pub.Equal(k.Public())
+}
+
+// Ed25519 keys have no legacy encoding, so they're always encoded as PKCS#8
+func (k privateKeyEd25519) marshal() ([]byte, error) {
+	return x509.MarshalPKCS8PrivateKey(k.PrivateKey)
+}
+
+func (k privateKeyEd25519) pemType() string {
+	return "PRIVATE KEY"
+}
+
+func (k privateKeyEd25519) unwrap() crypto.Signer {
+	return k.PrivateKey
+}
+
+// NewGenericPrivateKey wraps an ECDSA, RSA or Ed25519 private key.
+func NewGenericPrivateKey(key crypto.Signer) (GenericPrivateKey, error) {
+	switch k := key.(type) {
+	case GenericPrivateKey:
+		return k, nil
+	case *ecdsa.PrivateKey:
+		return privateKeyEC{k}, nil
+	case *rsa.PrivateKey:
+		return privateKeyRSA{k}, nil
+	case ed25519.PrivateKey:
+		return privateKeyEd25519{k}, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type: '%T', linkerd2 only supports ECDSA, RSA and Ed25519 private keys", key)
+	}
+}
+
 // validCredOrPanic creates a  Cred, panicking if the key does not match the certificate.
-func validCredOrPanic(ecKey *ecdsa.PrivateKey, crt Crt) Cred {
-	k := privateKeyEC{ecKey}
+func validCredOrPanic(key crypto.Signer, crt Crt) Cred {
+	k, err := NewGenericPrivateKey(key)
+	if err != nil {
+		panic(err)
+	}
 	if !k.matchesCertificate(crt.Certificate) {
 		panic("Cert's public key does not match private key")
 	}
@@ -139,16 +203,19 @@ func (cred *Cred) EncodePrivateKeyPEM() string {
 		panic(fmt.Sprintf("Invalid private key: %s", err))
 	}
 
-	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b}))
+	return string(pem.EncodeToMemory(&pem.Block{Type: cred.PrivateKey.pemType(), Bytes: b}))
 }
 
 // EncodePrivateKeyP8 encodes the provided key to the PKCS#8 binary form.
 func (cred *Cred) EncodePrivateKeyP8() ([]byte, error) {
-	return x509.MarshalPKCS8PrivateKey(cred.PrivateKey)
+	return x509.MarshalPKCS8PrivateKey(cred.PrivateKey.unwrap())
 }
 
 // SignCrt uses this Cred to sign a new certificate.
 //
+// The certificate is signed with the default signature algorithm of the
+// Cred's key type unless the template sets one.
+//
 // This may fail if the Cred contains an end-entity certificate.
 func (cred *Cred) SignCrt(template *x509.Certificate) (Crt, error) {
 	crtb, err := x509.CreateCertificate(
@@ -156,7 +223,7 @@ func (cred *Cred) SignCrt(template *x509.Certificate) (Crt, error) {
 		template,
 		cred.Crt.Certificate,
 		template.PublicKey,
-		cred.PrivateKey,
+		cred.PrivateKey.unwrap(),
 	)
 	if err != nil {
 		return Crt{}, err
@@ -139,6 +139,8 @@ func (h *handler) handleAPIStat(w http.ResponseWriter, req *http.Request, p http
 	requestParams := util.StatsSummaryRequestParams{
 		StatsBaseRequestParams: util.StatsBaseRequestParams{
 			TimeWindow:    req.FormValue("window"),
+			StartTime:     req.FormValue("start_time"),
+			EndTime:       req.FormValue("end_time"),
 			ResourceName:  req.FormValue("resource_name"),
 			ResourceType:  req.FormValue("resource_type"),
 			Namespace:     req.FormValue("namespace"),
@@ -186,6 +188,8 @@ func (h *handler) handleAPITopRoutes(w http.ResponseWriter, req *http.Request, p
 	requestParams := util.TopRoutesRequestParams{
 		StatsBaseRequestParams: util.StatsBaseRequestParams{
 			TimeWindow:   req.FormValue("window"),
+			StartTime:    req.FormValue("start_time"),
+			EndTime:      req.FormValue("end_time"),
 			ResourceName: req.FormValue("resource_name"),
 			ResourceType: req.FormValue("resource_type"),
 			Namespace:    req.FormValue("namespace"),
@@ -330,6 +334,8 @@ func (h *handler) handleAPIEdges(w http.ResponseWriter, req *http.Request, p htt
 	requestParams := util.EdgesRequestParams{
 		Namespace:    req.FormValue("namespace"),
 		ResourceType: req.FormValue("resource_type"),
+		StartTime:    req.FormValue("start_time"),
+		EndTime:      req.FormValue("end_time"),
 	}
 
 	edgesRequest, err := util.BuildEdgesRequest(requestParams)